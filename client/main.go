@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"flag"
 	"fmt"
 	"log"
@@ -15,7 +19,6 @@ import (
 
 	"github.com/atsevan/wireguard-grpc/client/testsetup"
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
-	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -25,11 +28,14 @@ import (
 var (
 	host         = flag.String("host", "localhost", "Wireguard GRPC server host")
 	port         = flag.Int("port", 8080, "Wireguard GRPC server port")
-	certFile     = flag.String("cert", "certs/client.crt", "path to RSA certificate")
+	certFile     = flag.String("cert", "certs/client.crt", "path to RSA certificate (no client certificate if empty)")
 	keyFile      = flag.String("key", "certs/client.key", "path to RSA Private key")
 	caFile       = flag.String("ca", "certs/ca.crt", "path to CA certificate")
 	insecureFlag = flag.Bool("insecure", false, "no credentials in use")
+	enrollToken  = flag.String("enroll", "", "enroll with this one-time token: write a new key to -key and the issued certificate to -cert, then exit")
+	tokenFile    = flag.String("token-file", "", "path to a file holding a bearer API token or JWT sent with every call")
 	confDevice   = flag.Bool("configuretest", false, "configure 'wg0' device and add a peer")
+	qrFile       = flag.String("qr", "", "write the client configuration of the new peer as a PNG QR code to this file")
 )
 
 const (
@@ -47,20 +53,6 @@ const (
   listening port: %d
 
   `
-	peerConfigTmpl = `
-## BEGIN(Client configugration)
-
-[Interface]
-PrivateKey = %s
-Address = %s/24
-
-[Peer]
-PublicKey = %s
-AllowedIPs = 0.0.0.0/0, ::/0
-Endpoint = %s:%d
-
-## END(Client configuration)
-`
 )
 
 func stringPeer(p *pb.Peer) string {
@@ -85,10 +77,15 @@ func stringPeer(p *pb.Peer) string {
 }
 
 // transportCredentialsFromTLS creates TransportCredentials based on TLS certificate
+// No client certificate is presented if certPath is empty.
 func transportCredentialsFromTLS(certPath string, keyPath string, caPath string, serverName string) (credentials.TransportCredentials, error) {
-	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
-	if err != nil {
-		return nil, fmt.Errorf("read RSA key pair: %s", err)
+	var certificates []tls.Certificate
+	if certPath != "" {
+		certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("read RSA key pair: %s", err)
+		}
+		certificates = append(certificates, certificate)
 	}
 	ca, err := os.ReadFile(caPath)
 	if err != nil {
@@ -102,11 +99,71 @@ func transportCredentialsFromTLS(certPath string, keyPath string, caPath string,
 	}
 	return credentials.NewTLS(&tls.Config{
 		ServerName:   serverName,
-		Certificates: []tls.Certificate{certificate},
+		Certificates: certificates,
 		RootCAs:      certPool,
 	}), nil
 }
 
+// bearerToken sends a token in the authorization metadata of every call.
+type bearerToken string
+
+// readBearerToken reads a token from path, ignoring surrounding whitespace.
+func readBearerToken(path string) (bearerToken, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read token: %s", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return bearerToken(token), nil
+}
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows sending the token in plain text only with
+// -insecure, e.g. to a TLS terminating proxy on the same host.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return !*insecureFlag
+}
+
+// enroll exchanges a one-time token for a client certificate of a new key.
+// It doesn't overwrite an existing certificate or key.
+func enroll(ctx context.Context, client pb.WireGuardClient, token, certPath, keyPath string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	for _, path := range []string{certPath, keyPath} {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s exists", path)
+		}
+	}
+	resp, err := client.Enroll(ctx, &pb.EnrollRequest{Token: token, Csr: csr})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(certPath, resp.GetCertificate(), 0o644); err != nil {
+		return err
+	}
+	log.Printf("wrote %s and %s", certPath, keyPath)
+	return nil
+}
+
 func main() {
 	flag.Parse()
 
@@ -117,7 +174,12 @@ func main() {
 		log.Println("No transport security in use")
 		creds = insecure.NewCredentials()
 	} else {
-		creds, err = transportCredentialsFromTLS(*certFile, *keyFile, *caFile, *host)
+		clientCert := *certFile
+		if *enrollToken != "" {
+			// The certificate doesn't exist yet.
+			clientCert = ""
+		}
+		creds, err = transportCredentialsFromTLS(clientCert, *keyFile, *caFile, *host)
 		if err != nil {
 			log.Fatalf("trasport credentials from TLS: %s", err)
 		}
@@ -126,6 +188,13 @@ func main() {
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 	}
+	if *tokenFile != "" {
+		token, err := readBearerToken(*tokenFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(token))
+	}
 
 	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancelCtx()
@@ -138,8 +207,15 @@ func main() {
 
 	client := pb.NewWireGuardClient(conn)
 
+	if *enrollToken != "" {
+		if err := enroll(ctx, client, *enrollToken, *certFile, *keyFile); err != nil {
+			log.Fatalf("enroll: %v", err)
+		}
+		return
+	}
+
 	if *confDevice == true {
-		ip := net.ParseIP("192.168.2.2")
+		ip := net.ParseIP("192.168.2.2").To4()
 		devName := "wg0"
 		listenPort := int32(51820)
 
@@ -153,14 +229,7 @@ func main() {
 			log.Fatalf("create Wireguard setup: %v", err)
 		}
 
-		peerPrivateKey, err := wgtypes.GeneratePrivateKey()
-		if err != nil {
-			log.Fatalf("generate peer key: %v", err)
-		}
-		peerPublicKey := peerPrivateKey.PublicKey()
-
 		peer := &pb.PeerConfig{
-			PublicKey: peerPublicKey[:],
 			AllowedIps: []*pb.IPNet{
 				{
 					Ip:     ip,
@@ -169,20 +238,27 @@ func main() {
 			},
 			ReplaceAllowedIps: true,
 		}
-		err = wgSetup.AddPeer(ctx, peer)
+		newPeer, peerPrivateKey, err := wgSetup.AddGeneratedPeer(ctx, peer)
 		if err != nil {
 			log.Fatalf("add peer: %s", err)
 		}
 
 		log.Println("New peer has been added")
-		fmt.Printf(
-			peerConfigTmpl,
-			peerPrivateKey,
-			ip,
-			wgSetup.PublicKey,
-			*host,
-			listenPort,
-		)
+		peerConfig, err := client.GeneratePeerConfig(ctx, &pb.GeneratePeerConfigRequest{
+			Name:         devName,
+			PublicKey:    newPeer.PublicKey,
+			PrivateKey:   peerPrivateKey,
+			EndpointHost: *host,
+		})
+		if err != nil {
+			log.Fatalf("generate peer config: %s", err)
+		}
+		fmt.Printf("\n## BEGIN(Client configuration)\n\n%s\n## END(Client configuration)\n", peerConfig.Config)
+		if *qrFile != "" {
+			if err := os.WriteFile(*qrFile, peerConfig.QrCodePng, 0o600); err != nil {
+				log.Fatalf("write QR code: %s", err)
+			}
+		}
 		fmt.Println()
 
 	}
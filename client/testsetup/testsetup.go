@@ -6,6 +6,7 @@ import (
 
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
 )
 
 // TestWGSetup keeps data about the Wireguard setup
@@ -34,8 +35,8 @@ func NewTestWGSetup(client pb.WireGuardClient, interfaceName string, listenPort
 
 // InitWGDevice configure the wireguard device
 //
-// It expects that the wireguard device exists.
-// For Linux it can be configured like:
+// It expects that the wireguard device exists. It can be created remotely
+// with the CreateDevice RPC, or on Linux by hand like:
 //
 //	sudo ip link add dev wg0 type wireguard
 //	sudo ip address add 192.168.2.1/24 dev wg0
@@ -45,7 +46,7 @@ func (s *TestWGSetup) InitWGDevice(ctx context.Context) error {
 		Name: s.interfaceName,
 		Config: &pb.Config{
 			PrivateKey:   s.serverPrivateKey[:],
-			ListenPort:   s.listenPort,
+			ListenPort:   proto.Int32(s.listenPort),
 			Peers:        []*pb.PeerConfig{},
 			ReplacePeers: true,
 		},
@@ -55,13 +56,23 @@ func (s *TestWGSetup) InitWGDevice(ctx context.Context) error {
 
 // AddPeer adds a new peer
 func (s *TestWGSetup) AddPeer(ctx context.Context, peer *pb.PeerConfig) error {
-	_, err := s.client.ConfigureDevice(ctx, &pb.ConfigureDeviceRequest{
+	_, err := s.client.AddPeer(ctx, &pb.AddPeerRequest{
 		Name: s.interfaceName,
-		Config: &pb.Config{
-			PrivateKey: s.serverPrivateKey[:],
-			ListenPort: s.listenPort,
-			Peers:      []*pb.PeerConfig{peer},
-		},
+		Peer: peer,
 	})
 	return err
 }
+
+// AddGeneratedPeer adds a new peer with a key pair generated by the server
+// and returns the peer and its private key.
+func (s *TestWGSetup) AddGeneratedPeer(ctx context.Context, peer *pb.PeerConfig) (*pb.Peer, []byte, error) {
+	resp, err := s.client.AddPeer(ctx, &pb.AddPeerRequest{
+		Name:            s.interfaceName,
+		Peer:            peer,
+		GenerateKeypair: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetPeer(), resp.GetPrivateKey(), nil
+}
@@ -8,6 +8,7 @@ import (
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 var (
@@ -67,11 +68,89 @@ func TestInitWGDevice(t *testing.T) {
 	}
 }
 
+func TestAddPeer(t *testing.T) {
+	peer := &pb.PeerConfig{PublicKey: make([]byte, 32)}
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "OK",
+			err:  nil,
+		},
+		{
+			name: "NotOk",
+			err:  os.ErrExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *pb.AddPeerRequest
+			client := testClient{
+				AddPeerFunc: func(ctx context.Context, in *pb.AddPeerRequest, opts ...grpc.CallOption) (*pb.AddPeerResponse, error) {
+					got = in
+					return &pb.AddPeerResponse{}, tt.err
+				},
+			}
+			wgs, _ := NewTestWGSetup(client, "wg0", 51280)
+			err := wgs.AddPeer(context.Background(), peer)
+			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+			want := &pb.AddPeerRequest{Name: "wg0", Peer: peer}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddGeneratedPeer(t *testing.T) {
+	peer := &pb.PeerConfig{AllowedIps: []*pb.IPNet{{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}}}
+	resp := &pb.AddPeerResponse{
+		Peer:       &pb.Peer{PublicKey: make([]byte, 32)},
+		PrivateKey: append(make([]byte, 31), 1),
+	}
+	var got *pb.AddPeerRequest
+	client := testClient{
+		AddPeerFunc: func(ctx context.Context, in *pb.AddPeerRequest, opts ...grpc.CallOption) (*pb.AddPeerResponse, error) {
+			got = in
+			return resp, nil
+		},
+	}
+	wgs, _ := NewTestWGSetup(client, "wg0", 51280)
+	p, privateKey, err := wgs.AddGeneratedPeer(context.Background(), peer)
+	if err != nil {
+		t.Fatalf("AddGeneratedPeer: %s", err)
+	}
+	want := &pb.AddPeerRequest{Name: "wg0", Peer: peer, GenerateKeypair: true}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected request (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(resp.Peer, p, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected peer (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(resp.PrivateKey, privateKey); diff != "" {
+		t.Fatalf("unexpected private key (-want +got):\n%s", diff)
+	}
+
+	client.AddPeerFunc = func(ctx context.Context, in *pb.AddPeerRequest, opts ...grpc.CallOption) (*pb.AddPeerResponse, error) {
+		return nil, os.ErrExist
+	}
+	wgs, _ = NewTestWGSetup(client, "wg0", 51280)
+	if _, _, err := wgs.AddGeneratedPeer(context.Background(), peer); err != os.ErrExist {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 type testClient struct {
+	pb.WireGuardClient
 	CloseFunc           func() error
 	DevicesFunc         func(ctx context.Context, in *pb.DevicesRequest, opts ...grpc.CallOption) (*pb.DevicesResponse, error)
 	DeviceFunc          func(ctx context.Context, in *pb.DeviceRequest, opts ...grpc.CallOption) (*pb.DeviceResponse, error)
 	ConfigureDeviceFunc func(ctx context.Context, in *pb.ConfigureDeviceRequest, opts ...grpc.CallOption) (*pb.ConfigureDeviceResponse, error)
+	AddPeerFunc         func(ctx context.Context, in *pb.AddPeerRequest, opts ...grpc.CallOption) (*pb.AddPeerResponse, error)
 }
 
 func (c testClient) Close() error { return c.CloseFunc() }
@@ -87,3 +166,7 @@ func (c testClient) Device(ctx context.Context, in *pb.DeviceRequest, opts ...gr
 func (c testClient) ConfigureDevice(ctx context.Context, in *pb.ConfigureDeviceRequest, opts ...grpc.CallOption) (*pb.ConfigureDeviceResponse, error) {
 	return c.ConfigureDeviceFunc(ctx, in, opts...)
 }
+
+func (c testClient) AddPeer(ctx context.Context, in *pb.AddPeerRequest, opts ...grpc.CallOption) (*pb.AddPeerResponse, error) {
+	return c.AddPeerFunc(ctx, in, opts...)
+}
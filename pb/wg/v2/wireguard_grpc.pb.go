@@ -0,0 +1,1089 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.29.3
+// source: v2/wireguard.proto
+
+package wgv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WireGuard_ConfigureDevice_FullMethodName      = "/wireguard.v2.WireGuard/ConfigureDevice"
+	WireGuard_Devices_FullMethodName              = "/wireguard.v2.WireGuard/Devices"
+	WireGuard_Device_FullMethodName               = "/wireguard.v2.WireGuard/Device"
+	WireGuard_CreateDevice_FullMethodName         = "/wireguard.v2.WireGuard/CreateDevice"
+	WireGuard_DeleteDevice_FullMethodName         = "/wireguard.v2.WireGuard/DeleteDevice"
+	WireGuard_AddAddress_FullMethodName           = "/wireguard.v2.WireGuard/AddAddress"
+	WireGuard_RemoveAddress_FullMethodName        = "/wireguard.v2.WireGuard/RemoveAddress"
+	WireGuard_AddPeer_FullMethodName              = "/wireguard.v2.WireGuard/AddPeer"
+	WireGuard_UpdatePeer_FullMethodName           = "/wireguard.v2.WireGuard/UpdatePeer"
+	WireGuard_RemovePeer_FullMethodName           = "/wireguard.v2.WireGuard/RemovePeer"
+	WireGuard_GetPeer_FullMethodName              = "/wireguard.v2.WireGuard/GetPeer"
+	WireGuard_ListPeers_FullMethodName            = "/wireguard.v2.WireGuard/ListPeers"
+	WireGuard_SetMTU_FullMethodName               = "/wireguard.v2.WireGuard/SetMTU"
+	WireGuard_SetLinkState_FullMethodName         = "/wireguard.v2.WireGuard/SetLinkState"
+	WireGuard_WatchDevice_FullMethodName          = "/wireguard.v2.WireGuard/WatchDevice"
+	WireGuard_WatchDevices_FullMethodName         = "/wireguard.v2.WireGuard/WatchDevices"
+	WireGuard_Reconcile_FullMethodName            = "/wireguard.v2.WireGuard/Reconcile"
+	WireGuard_ExportConfig_FullMethodName         = "/wireguard.v2.WireGuard/ExportConfig"
+	WireGuard_ImportConfig_FullMethodName         = "/wireguard.v2.WireGuard/ImportConfig"
+	WireGuard_GeneratePeerConfig_FullMethodName   = "/wireguard.v2.WireGuard/GeneratePeerConfig"
+	WireGuard_GenerateKeyPair_FullMethodName      = "/wireguard.v2.WireGuard/GenerateKeyPair"
+	WireGuard_GeneratePresharedKey_FullMethodName = "/wireguard.v2.WireGuard/GeneratePresharedKey"
+	WireGuard_DerivePublicKey_FullMethodName      = "/wireguard.v2.WireGuard/DerivePublicKey"
+	WireGuard_SetAddressPools_FullMethodName      = "/wireguard.v2.WireGuard/SetAddressPools"
+	WireGuard_GetAddressPools_FullMethodName      = "/wireguard.v2.WireGuard/GetAddressPools"
+	WireGuard_AllocatePeer_FullMethodName         = "/wireguard.v2.WireGuard/AllocatePeer"
+)
+
+// WireGuardClient is the client API for WireGuard service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WireGuardClient interface {
+	ConfigureDevice(ctx context.Context, in *ConfigureDeviceRequest, opts ...grpc.CallOption) (*ConfigureDeviceResponse, error)
+	Devices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error)
+	Device(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*DeviceResponse, error)
+	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CreateDeviceResponse, error)
+	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error)
+	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error)
+	RemoveAddress(ctx context.Context, in *RemoveAddressRequest, opts ...grpc.CallOption) (*RemoveAddressResponse, error)
+	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error)
+	UpdatePeer(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*UpdatePeerResponse, error)
+	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*RemovePeerResponse, error)
+	GetPeer(ctx context.Context, in *GetPeerRequest, opts ...grpc.CallOption) (*GetPeerResponse, error)
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	SetMTU(ctx context.Context, in *SetMTURequest, opts ...grpc.CallOption) (*SetMTUResponse, error)
+	SetLinkState(ctx context.Context, in *SetLinkStateRequest, opts ...grpc.CallOption) (*SetLinkStateResponse, error)
+	WatchDevice(ctx context.Context, in *WatchDeviceRequest, opts ...grpc.CallOption) (WireGuard_WatchDeviceClient, error)
+	WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (WireGuard_WatchDevicesClient, error)
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error)
+	ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error)
+	GeneratePeerConfig(ctx context.Context, in *GeneratePeerConfigRequest, opts ...grpc.CallOption) (*GeneratePeerConfigResponse, error)
+	GenerateKeyPair(ctx context.Context, in *GenerateKeyPairRequest, opts ...grpc.CallOption) (*GenerateKeyPairResponse, error)
+	GeneratePresharedKey(ctx context.Context, in *GeneratePresharedKeyRequest, opts ...grpc.CallOption) (*GeneratePresharedKeyResponse, error)
+	DerivePublicKey(ctx context.Context, in *DerivePublicKeyRequest, opts ...grpc.CallOption) (*DerivePublicKeyResponse, error)
+	SetAddressPools(ctx context.Context, in *SetAddressPoolsRequest, opts ...grpc.CallOption) (*SetAddressPoolsResponse, error)
+	GetAddressPools(ctx context.Context, in *GetAddressPoolsRequest, opts ...grpc.CallOption) (*GetAddressPoolsResponse, error)
+	AllocatePeer(ctx context.Context, in *AllocatePeerRequest, opts ...grpc.CallOption) (*AllocatePeerResponse, error)
+}
+
+type wireGuardClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWireGuardClient(cc grpc.ClientConnInterface) WireGuardClient {
+	return &wireGuardClient{cc}
+}
+
+func (c *wireGuardClient) ConfigureDevice(ctx context.Context, in *ConfigureDeviceRequest, opts ...grpc.CallOption) (*ConfigureDeviceResponse, error) {
+	out := new(ConfigureDeviceResponse)
+	err := c.cc.Invoke(ctx, WireGuard_ConfigureDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) Devices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error) {
+	out := new(DevicesResponse)
+	err := c.cc.Invoke(ctx, WireGuard_Devices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) Device(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*DeviceResponse, error) {
+	out := new(DeviceResponse)
+	err := c.cc.Invoke(ctx, WireGuard_Device_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CreateDeviceResponse, error) {
+	out := new(CreateDeviceResponse)
+	err := c.cc.Invoke(ctx, WireGuard_CreateDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error) {
+	out := new(DeleteDeviceResponse)
+	err := c.cc.Invoke(ctx, WireGuard_DeleteDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error) {
+	out := new(AddAddressResponse)
+	err := c.cc.Invoke(ctx, WireGuard_AddAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) RemoveAddress(ctx context.Context, in *RemoveAddressRequest, opts ...grpc.CallOption) (*RemoveAddressResponse, error) {
+	out := new(RemoveAddressResponse)
+	err := c.cc.Invoke(ctx, WireGuard_RemoveAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error) {
+	out := new(AddPeerResponse)
+	err := c.cc.Invoke(ctx, WireGuard_AddPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) UpdatePeer(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*UpdatePeerResponse, error) {
+	out := new(UpdatePeerResponse)
+	err := c.cc.Invoke(ctx, WireGuard_UpdatePeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*RemovePeerResponse, error) {
+	out := new(RemovePeerResponse)
+	err := c.cc.Invoke(ctx, WireGuard_RemovePeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) GetPeer(ctx context.Context, in *GetPeerRequest, opts ...grpc.CallOption) (*GetPeerResponse, error) {
+	out := new(GetPeerResponse)
+	err := c.cc.Invoke(ctx, WireGuard_GetPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	out := new(ListPeersResponse)
+	err := c.cc.Invoke(ctx, WireGuard_ListPeers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) SetMTU(ctx context.Context, in *SetMTURequest, opts ...grpc.CallOption) (*SetMTUResponse, error) {
+	out := new(SetMTUResponse)
+	err := c.cc.Invoke(ctx, WireGuard_SetMTU_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) SetLinkState(ctx context.Context, in *SetLinkStateRequest, opts ...grpc.CallOption) (*SetLinkStateResponse, error) {
+	out := new(SetLinkStateResponse)
+	err := c.cc.Invoke(ctx, WireGuard_SetLinkState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) WatchDevice(ctx context.Context, in *WatchDeviceRequest, opts ...grpc.CallOption) (WireGuard_WatchDeviceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WireGuard_ServiceDesc.Streams[0], WireGuard_WatchDevice_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wireGuardWatchDeviceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WireGuard_WatchDeviceClient interface {
+	Recv() (*DeviceEvent, error)
+	grpc.ClientStream
+}
+
+type wireGuardWatchDeviceClient struct {
+	grpc.ClientStream
+}
+
+func (x *wireGuardWatchDeviceClient) Recv() (*DeviceEvent, error) {
+	m := new(DeviceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wireGuardClient) WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (WireGuard_WatchDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WireGuard_ServiceDesc.Streams[1], WireGuard_WatchDevices_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wireGuardWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WireGuard_WatchDevicesClient interface {
+	Recv() (*DeviceEvent, error)
+	grpc.ClientStream
+}
+
+type wireGuardWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *wireGuardWatchDevicesClient) Recv() (*DeviceEvent, error) {
+	m := new(DeviceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wireGuardClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, WireGuard_Reconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error) {
+	out := new(ExportConfigResponse)
+	err := c.cc.Invoke(ctx, WireGuard_ExportConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error) {
+	out := new(ImportConfigResponse)
+	err := c.cc.Invoke(ctx, WireGuard_ImportConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) GeneratePeerConfig(ctx context.Context, in *GeneratePeerConfigRequest, opts ...grpc.CallOption) (*GeneratePeerConfigResponse, error) {
+	out := new(GeneratePeerConfigResponse)
+	err := c.cc.Invoke(ctx, WireGuard_GeneratePeerConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) GenerateKeyPair(ctx context.Context, in *GenerateKeyPairRequest, opts ...grpc.CallOption) (*GenerateKeyPairResponse, error) {
+	out := new(GenerateKeyPairResponse)
+	err := c.cc.Invoke(ctx, WireGuard_GenerateKeyPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) GeneratePresharedKey(ctx context.Context, in *GeneratePresharedKeyRequest, opts ...grpc.CallOption) (*GeneratePresharedKeyResponse, error) {
+	out := new(GeneratePresharedKeyResponse)
+	err := c.cc.Invoke(ctx, WireGuard_GeneratePresharedKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) DerivePublicKey(ctx context.Context, in *DerivePublicKeyRequest, opts ...grpc.CallOption) (*DerivePublicKeyResponse, error) {
+	out := new(DerivePublicKeyResponse)
+	err := c.cc.Invoke(ctx, WireGuard_DerivePublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) SetAddressPools(ctx context.Context, in *SetAddressPoolsRequest, opts ...grpc.CallOption) (*SetAddressPoolsResponse, error) {
+	out := new(SetAddressPoolsResponse)
+	err := c.cc.Invoke(ctx, WireGuard_SetAddressPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) GetAddressPools(ctx context.Context, in *GetAddressPoolsRequest, opts ...grpc.CallOption) (*GetAddressPoolsResponse, error) {
+	out := new(GetAddressPoolsResponse)
+	err := c.cc.Invoke(ctx, WireGuard_GetAddressPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireGuardClient) AllocatePeer(ctx context.Context, in *AllocatePeerRequest, opts ...grpc.CallOption) (*AllocatePeerResponse, error) {
+	out := new(AllocatePeerResponse)
+	err := c.cc.Invoke(ctx, WireGuard_AllocatePeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WireGuardServer is the server API for WireGuard service.
+// All implementations must embed UnimplementedWireGuardServer
+// for forward compatibility
+type WireGuardServer interface {
+	ConfigureDevice(context.Context, *ConfigureDeviceRequest) (*ConfigureDeviceResponse, error)
+	Devices(context.Context, *DevicesRequest) (*DevicesResponse, error)
+	Device(context.Context, *DeviceRequest) (*DeviceResponse, error)
+	CreateDevice(context.Context, *CreateDeviceRequest) (*CreateDeviceResponse, error)
+	DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error)
+	AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error)
+	RemoveAddress(context.Context, *RemoveAddressRequest) (*RemoveAddressResponse, error)
+	AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error)
+	UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error)
+	RemovePeer(context.Context, *RemovePeerRequest) (*RemovePeerResponse, error)
+	GetPeer(context.Context, *GetPeerRequest) (*GetPeerResponse, error)
+	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	SetMTU(context.Context, *SetMTURequest) (*SetMTUResponse, error)
+	SetLinkState(context.Context, *SetLinkStateRequest) (*SetLinkStateResponse, error)
+	WatchDevice(*WatchDeviceRequest, WireGuard_WatchDeviceServer) error
+	WatchDevices(*WatchDevicesRequest, WireGuard_WatchDevicesServer) error
+	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error)
+	ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error)
+	GeneratePeerConfig(context.Context, *GeneratePeerConfigRequest) (*GeneratePeerConfigResponse, error)
+	GenerateKeyPair(context.Context, *GenerateKeyPairRequest) (*GenerateKeyPairResponse, error)
+	GeneratePresharedKey(context.Context, *GeneratePresharedKeyRequest) (*GeneratePresharedKeyResponse, error)
+	DerivePublicKey(context.Context, *DerivePublicKeyRequest) (*DerivePublicKeyResponse, error)
+	SetAddressPools(context.Context, *SetAddressPoolsRequest) (*SetAddressPoolsResponse, error)
+	GetAddressPools(context.Context, *GetAddressPoolsRequest) (*GetAddressPoolsResponse, error)
+	AllocatePeer(context.Context, *AllocatePeerRequest) (*AllocatePeerResponse, error)
+	mustEmbedUnimplementedWireGuardServer()
+}
+
+// UnimplementedWireGuardServer must be embedded to have forward compatible implementations.
+type UnimplementedWireGuardServer struct {
+}
+
+func (UnimplementedWireGuardServer) ConfigureDevice(context.Context, *ConfigureDeviceRequest) (*ConfigureDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureDevice not implemented")
+}
+func (UnimplementedWireGuardServer) Devices(context.Context, *DevicesRequest) (*DevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Devices not implemented")
+}
+func (UnimplementedWireGuardServer) Device(context.Context, *DeviceRequest) (*DeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Device not implemented")
+}
+func (UnimplementedWireGuardServer) CreateDevice(context.Context, *CreateDeviceRequest) (*CreateDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDevice not implemented")
+}
+func (UnimplementedWireGuardServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
+}
+func (UnimplementedWireGuardServer) AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAddress not implemented")
+}
+func (UnimplementedWireGuardServer) RemoveAddress(context.Context, *RemoveAddressRequest) (*RemoveAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAddress not implemented")
+}
+func (UnimplementedWireGuardServer) AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
+}
+func (UnimplementedWireGuardServer) UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeer not implemented")
+}
+func (UnimplementedWireGuardServer) RemovePeer(context.Context, *RemovePeerRequest) (*RemovePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeer not implemented")
+}
+func (UnimplementedWireGuardServer) GetPeer(context.Context, *GetPeerRequest) (*GetPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (UnimplementedWireGuardServer) ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedWireGuardServer) SetMTU(context.Context, *SetMTURequest) (*SetMTUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMTU not implemented")
+}
+func (UnimplementedWireGuardServer) SetLinkState(context.Context, *SetLinkStateRequest) (*SetLinkStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkState not implemented")
+}
+func (UnimplementedWireGuardServer) WatchDevice(*WatchDeviceRequest, WireGuard_WatchDeviceServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevice not implemented")
+}
+func (UnimplementedWireGuardServer) WatchDevices(*WatchDevicesRequest, WireGuard_WatchDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
+func (UnimplementedWireGuardServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedWireGuardServer) ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (UnimplementedWireGuardServer) ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfig not implemented")
+}
+func (UnimplementedWireGuardServer) GeneratePeerConfig(context.Context, *GeneratePeerConfigRequest) (*GeneratePeerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePeerConfig not implemented")
+}
+func (UnimplementedWireGuardServer) GenerateKeyPair(context.Context, *GenerateKeyPairRequest) (*GenerateKeyPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateKeyPair not implemented")
+}
+func (UnimplementedWireGuardServer) GeneratePresharedKey(context.Context, *GeneratePresharedKeyRequest) (*GeneratePresharedKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePresharedKey not implemented")
+}
+func (UnimplementedWireGuardServer) DerivePublicKey(context.Context, *DerivePublicKeyRequest) (*DerivePublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivePublicKey not implemented")
+}
+func (UnimplementedWireGuardServer) SetAddressPools(context.Context, *SetAddressPoolsRequest) (*SetAddressPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressPools not implemented")
+}
+func (UnimplementedWireGuardServer) GetAddressPools(context.Context, *GetAddressPoolsRequest) (*GetAddressPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressPools not implemented")
+}
+func (UnimplementedWireGuardServer) AllocatePeer(context.Context, *AllocatePeerRequest) (*AllocatePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocatePeer not implemented")
+}
+func (UnimplementedWireGuardServer) mustEmbedUnimplementedWireGuardServer() {}
+
+// UnsafeWireGuardServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WireGuardServer will
+// result in compilation errors.
+type UnsafeWireGuardServer interface {
+	mustEmbedUnimplementedWireGuardServer()
+}
+
+func RegisterWireGuardServer(s grpc.ServiceRegistrar, srv WireGuardServer) {
+	s.RegisterService(&WireGuard_ServiceDesc, srv)
+}
+
+func _WireGuard_ConfigureDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).ConfigureDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_ConfigureDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).ConfigureDevice(ctx, req.(*ConfigureDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_Devices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).Devices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_Devices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).Devices(ctx, req.(*DevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_Device_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).Device(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_Device_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).Device(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).CreateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_CreateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).CreateDevice(ctx, req.(*CreateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_DeleteDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).AddAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_AddAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).AddAddress(ctx, req.(*AddAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_RemoveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).RemoveAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_RemoveAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).RemoveAddress(ctx, req.(*RemoveAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_AddPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).AddPeer(ctx, req.(*AddPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_UpdatePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).UpdatePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_UpdatePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).UpdatePeer(ctx, req.(*UpdatePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_RemovePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).RemovePeer(ctx, req.(*RemovePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).GetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_GetPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).GetPeer(ctx, req.(*GetPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_ListPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).ListPeers(ctx, req.(*ListPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_SetMTU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMTURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).SetMTU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_SetMTU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).SetMTU(ctx, req.(*SetMTURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_SetLinkState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).SetLinkState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_SetLinkState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).SetLinkState(ctx, req.(*SetLinkStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_WatchDevice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeviceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WireGuardServer).WatchDevice(m, &wireGuardWatchDeviceServer{stream})
+}
+
+type WireGuard_WatchDeviceServer interface {
+	Send(*DeviceEvent) error
+	grpc.ServerStream
+}
+
+type wireGuardWatchDeviceServer struct {
+	grpc.ServerStream
+}
+
+func (x *wireGuardWatchDeviceServer) Send(m *DeviceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WireGuard_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WireGuardServer).WatchDevices(m, &wireGuardWatchDevicesServer{stream})
+}
+
+type WireGuard_WatchDevicesServer interface {
+	Send(*DeviceEvent) error
+	grpc.ServerStream
+}
+
+type wireGuardWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *wireGuardWatchDevicesServer) Send(m *DeviceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WireGuard_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).Reconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_ExportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).ExportConfig(ctx, req.(*ExportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_ImportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).ImportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_ImportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).ImportConfig(ctx, req.(*ImportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_GeneratePeerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePeerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).GeneratePeerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_GeneratePeerConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).GeneratePeerConfig(ctx, req.(*GeneratePeerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_GenerateKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateKeyPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).GenerateKeyPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_GenerateKeyPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).GenerateKeyPair(ctx, req.(*GenerateKeyPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_GeneratePresharedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePresharedKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).GeneratePresharedKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_GeneratePresharedKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).GeneratePresharedKey(ctx, req.(*GeneratePresharedKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_DerivePublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DerivePublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).DerivePublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_DerivePublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).DerivePublicKey(ctx, req.(*DerivePublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_SetAddressPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).SetAddressPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_SetAddressPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).SetAddressPools(ctx, req.(*SetAddressPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_GetAddressPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).GetAddressPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_GetAddressPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).GetAddressPools(ctx, req.(*GetAddressPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireGuard_AllocatePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocatePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireGuardServer).AllocatePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireGuard_AllocatePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireGuardServer).AllocatePeer(ctx, req.(*AllocatePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WireGuard_ServiceDesc is the grpc.ServiceDesc for WireGuard service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WireGuard_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wireguard.v2.WireGuard",
+	HandlerType: (*WireGuardServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConfigureDevice",
+			Handler:    _WireGuard_ConfigureDevice_Handler,
+		},
+		{
+			MethodName: "Devices",
+			Handler:    _WireGuard_Devices_Handler,
+		},
+		{
+			MethodName: "Device",
+			Handler:    _WireGuard_Device_Handler,
+		},
+		{
+			MethodName: "CreateDevice",
+			Handler:    _WireGuard_CreateDevice_Handler,
+		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _WireGuard_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "AddAddress",
+			Handler:    _WireGuard_AddAddress_Handler,
+		},
+		{
+			MethodName: "RemoveAddress",
+			Handler:    _WireGuard_RemoveAddress_Handler,
+		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _WireGuard_AddPeer_Handler,
+		},
+		{
+			MethodName: "UpdatePeer",
+			Handler:    _WireGuard_UpdatePeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _WireGuard_RemovePeer_Handler,
+		},
+		{
+			MethodName: "GetPeer",
+			Handler:    _WireGuard_GetPeer_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _WireGuard_ListPeers_Handler,
+		},
+		{
+			MethodName: "SetMTU",
+			Handler:    _WireGuard_SetMTU_Handler,
+		},
+		{
+			MethodName: "SetLinkState",
+			Handler:    _WireGuard_SetLinkState_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _WireGuard_Reconcile_Handler,
+		},
+		{
+			MethodName: "ExportConfig",
+			Handler:    _WireGuard_ExportConfig_Handler,
+		},
+		{
+			MethodName: "ImportConfig",
+			Handler:    _WireGuard_ImportConfig_Handler,
+		},
+		{
+			MethodName: "GeneratePeerConfig",
+			Handler:    _WireGuard_GeneratePeerConfig_Handler,
+		},
+		{
+			MethodName: "GenerateKeyPair",
+			Handler:    _WireGuard_GenerateKeyPair_Handler,
+		},
+		{
+			MethodName: "GeneratePresharedKey",
+			Handler:    _WireGuard_GeneratePresharedKey_Handler,
+		},
+		{
+			MethodName: "DerivePublicKey",
+			Handler:    _WireGuard_DerivePublicKey_Handler,
+		},
+		{
+			MethodName: "SetAddressPools",
+			Handler:    _WireGuard_SetAddressPools_Handler,
+		},
+		{
+			MethodName: "GetAddressPools",
+			Handler:    _WireGuard_GetAddressPools_Handler,
+		},
+		{
+			MethodName: "AllocatePeer",
+			Handler:    _WireGuard_AllocatePeer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevice",
+			Handler:       _WireGuard_WatchDevice_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _WireGuard_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/wireguard.proto",
+}
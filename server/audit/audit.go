@@ -0,0 +1,328 @@
+// Package audit records mutating RPCs with the identity of their caller in
+// an append-only log.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	"github.com/atsevan/wireguard-grpc/server/authz"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Log is a file of AuditEntry messages in JSON, one per line. Entries are
+// only ever appended.
+type Log struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	now  func() time.Time
+}
+
+// Open opens the log at path for appending, creating it if it does not
+// exist.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{path: path, f: f, now: time.Now}, nil
+}
+
+// Close closes the log.
+func (l *Log) Close() error {
+	return l.f.Close()
+}
+
+// Write appends an entry and syncs it to disk.
+func (l *Log) Write(e *pb.AuditEntry) error {
+	b, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return l.f.Sync()
+}
+
+// Query returns the entries matching q, oldest first. A last line without
+// a newline is an entry being written, it is skipped.
+func (l *Log) Query(q *pb.QueryAuditLogRequest) ([]*pb.AuditEntry, error) {
+	if q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit: must not be negative, got %d", q.GetLimit())
+	}
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []*pb.AuditEntry
+	r := bufio.NewReader(f)
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		e := &pb.AuditEntry{}
+		if err := protojson.Unmarshal(line, e); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", l.path, n, err)
+		}
+		if !matches(e, q) {
+			continue
+		}
+		entries = append(entries, e)
+		if limit := int(q.GetLimit()); limit > 0 && len(entries) > limit {
+			entries = entries[1:]
+		}
+	}
+	return entries, nil
+}
+
+func matches(e *pb.AuditEntry, q *pb.QueryAuditLogRequest) bool {
+	t := e.GetTime().AsTime()
+	if q.Since != nil && t.Before(q.GetSince().AsTime()) {
+		return false
+	}
+	if q.Until != nil && !t.Before(q.GetUntil().AsTime()) {
+		return false
+	}
+	if q.GetName() == "" {
+		return true
+	}
+	for _, d := range e.GetDevices() {
+		if d == q.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor records every call of the methods, given by their
+// full names. Calls of other methods are not recorded.
+//
+// It must run before interceptors converting errors into statuses, so the
+// recorded code is the one returned to the caller. A failure to write the
+// log is logged, the call has already been handled then.
+func (l *Log) UnaryServerInterceptor(methods ...string) grpc.UnaryServerInterceptor {
+	audited := methodSet(methods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !audited[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		l.record(ctx, info.FullMethod, req, err)
+		return resp, err
+	}
+}
+
+// RecordRejections wraps an interceptor running before
+// UnaryServerInterceptor, like an authenticator, and records the calls of
+// methods it rejects without calling the handler. Calls it passes on are
+// left to UnaryServerInterceptor, which sees the identities it added.
+func (l *Log) RecordRejections(interceptor grpc.UnaryServerInterceptor, methods ...string) grpc.UnaryServerInterceptor {
+	audited := methodSet(methods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !audited[info.FullMethod] {
+			return interceptor(ctx, req, info, handler)
+		}
+		passed := false
+		resp, err := interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			passed = true
+			return handler(ctx, req)
+		})
+		if !passed {
+			l.record(ctx, info.FullMethod, req, err)
+		}
+		return resp, err
+	}
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
+
+// record writes the entry of a call. A failure is only logged.
+func (l *Log) record(ctx context.Context, method string, req interface{}, err error) {
+	e := NewEntry(ctx, method, req, err)
+	e.Time = timestamppb.New(l.now())
+	if werr := l.Write(e); werr != nil {
+		log.Printf("Audit %s: %v", method, werr)
+	}
+}
+
+// NewEntry describes a call of method. The caller is identified by the
+// peer of ctx and its client certificate. The time is not set.
+func NewEntry(ctx context.Context, method string, req interface{}, err error) *pb.AuditEntry {
+	e := &pb.AuditEntry{
+		Method:  method,
+		Devices: devices(req),
+		Request: summary(req),
+		Code:    status.Code(err).String(),
+	}
+	e.Identities = authz.Identities(ctx)
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return e
+	}
+	if p.Addr != nil {
+		e.PeerAddress = p.Addr.String()
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return e
+	}
+	cert := tlsInfo.State.PeerCertificates[0]
+	e.Subject = cert.Subject.String()
+	e.Sans = append(e.Sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		e.Sans = append(e.Sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		e.Sans = append(e.Sans, uri.String())
+	}
+	e.Sans = append(e.Sans, cert.EmailAddresses...)
+	return e
+}
+
+// devices returns the names of the devices a request changes.
+func devices(req interface{}) []string {
+	switch r := req.(type) {
+	case *pb.BatchConfigureRequest:
+		var names []string
+		seen := map[string]bool{}
+		for _, step := range r.GetSteps() {
+			if !seen[step.GetName()] {
+				seen[step.GetName()] = true
+				names = append(names, step.GetName())
+			}
+		}
+		return names
+	case interface{ GetName() string }:
+		if r.GetName() != "" {
+			return []string{r.GetName()}
+		}
+	}
+	return nil
+}
+
+// summary returns the request in JSON with its secrets redacted.
+func summary(req interface{}) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+	redact(v)
+	b, err = json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// redact replaces the private keys in a JSON value by their public keys
+// and preshared keys by "(secret)". Keys are base64 in both API versions.
+// Configuration files, e.g. of ImportConfig, are redacted the same way.
+func redact(v interface{}) {
+	switch x := v.(type) {
+	case []interface{}:
+		for _, e := range x {
+			redact(e)
+		}
+	case map[string]interface{}:
+		for k, e := range x {
+			switch s, isString := e.(string); {
+			case k == "privateKey" && isString:
+				delete(x, k)
+				if pub := publicKey(s); pub != "" {
+					x["publicKey"] = pub
+				} else {
+					x[k] = "(secret)"
+				}
+			case (k == "presharedKey" || k == "token") && isString:
+				x[k] = "(secret)"
+			case k == "config" && isString:
+				x[k] = redactConfig(s)
+			default:
+				redact(e)
+			}
+		}
+	}
+}
+
+// redactConfig redacts a configuration file like redact. A private key
+// is replaced by the line of its public key.
+func redactConfig(config string) string {
+	var buf bytes.Buffer
+	for _, line := range strings.SplitAfter(config, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if ok {
+			nl := line[len(strings.TrimRight(line, "\r\n")):]
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "privatekey":
+				if pub := publicKey(strings.TrimSpace(value)); pub != "" {
+					line = "PublicKey = " + pub + nl
+				} else {
+					line = "PrivateKey = (secret)" + nl
+				}
+			case "presharedkey":
+				line = "PresharedKey = (secret)" + nl
+			}
+		}
+		buf.WriteString(line)
+	}
+	return buf.String()
+}
+
+// publicKey returns the public key of a base64 private key, or an empty
+// string if it is not a key.
+func publicKey(privateKey string) string {
+	b, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return ""
+	}
+	k, err := wgtypes.NewKey(b)
+	if err != nil {
+		return ""
+	}
+	return k.PublicKey().String()
+}
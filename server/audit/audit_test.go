@@ -0,0 +1,256 @@
+package audit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	"github.com/atsevan/wireguard-grpc/server/authz"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestLog(t *testing.T) *Log {
+	t.Helper()
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l := newTestLog(t)
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	interceptor := l.UnaryServerInterceptor("/WireGuard/ConfigureDevice")
+
+	privateKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.GenerateKey()
+	peerKey, _ := wgtypes.GenerateKey()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IP{192, 0, 2, 1}, Port: 40000},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+			Subject:     pkix.Name{CommonName: "ops", Organization: []string{"example"}},
+			DNSNames:    []string{"ops.example.com"},
+			IPAddresses: []net.IP{{127, 0, 0, 1}},
+		}}}},
+	})
+	ctx = authz.WithIdentities(ctx, "token:ci")
+	req := &pb.ConfigureDeviceRequest{
+		Name: "wg0",
+		Config: &pb.Config{
+			PrivateKey: privateKey[:],
+			Peers:      []*pb.PeerConfig{{PublicKey: peerKey[:], PresharedKey: psk[:]}},
+		},
+	}
+	failed := status.Error(codes.Aborted, "device wg0 has changed")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, failed }
+	if _, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/WireGuard/ConfigureDevice"}, handler); err != failed {
+		t.Fatalf("the interceptor returned %v, want the error of the handler", err)
+	}
+	if _, err := interceptor(ctx, &pb.DeviceRequest{Name: "wg0"}, &grpc.UnaryServerInfo{FullMethod: "/WireGuard/Device"}, handler); err != failed {
+		t.Fatalf("the interceptor returned %v, want the error of the handler", err)
+	}
+
+	entries, err := l.Query(&pb.QueryAuditLogRequest{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want only the one of ConfigureDevice", len(entries))
+	}
+	var request map[string]interface{}
+	if err := json.Unmarshal([]byte(entries[0].GetRequest()), &request); err != nil {
+		t.Fatalf("request is not JSON: %v", err)
+	}
+	wantRequest := map[string]interface{}{
+		"name": "wg0",
+		"config": map[string]interface{}{
+			"publicKey": privateKey.PublicKey().String(),
+			"peers": []interface{}{map[string]interface{}{
+				"publicKey":    peerKey.String(),
+				"presharedKey": "(secret)",
+			}},
+		},
+	}
+	if diff := cmp.Diff(wantRequest, request); diff != "" {
+		t.Errorf("unexpected request (-want +got):\n%s", diff)
+	}
+	entries[0].Request = ""
+	want := &pb.AuditEntry{
+		Time:        timestamppb.New(now),
+		Subject:     "CN=ops,O=example",
+		Sans:        []string{"ops.example.com", "127.0.0.1"},
+		PeerAddress: "192.0.2.1:40000",
+		Method:      "/WireGuard/ConfigureDevice",
+		Devices:     []string{"wg0"},
+		Code:        "Aborted",
+		Identities:  []string{"cn:ops", "dns:ops.example.com", "token:ci"},
+	}
+	if diff := cmp.Diff(want, entries[0], protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected entry (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecordRejections(t *testing.T) {
+	l := newTestLog(t)
+	rejected := status.Error(codes.Unauthenticated, "invalid token")
+	authenticate := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/WireGuard/AddPeer" {
+			return nil, rejected
+		}
+		return handler(authz.WithIdentities(ctx, "token:ci"), req)
+	}
+	interceptor := l.RecordRejections(authenticate, "/WireGuard/AddPeer", "/WireGuard/RemovePeer")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IP{192, 0, 2, 1}, Port: 40000}})
+	for _, method := range []string{"/WireGuard/AddPeer", "/WireGuard/RemovePeer", "/WireGuard/Devices"} {
+		interceptor(ctx, &pb.AddPeerRequest{Name: "wg0"}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	// Only the rejected call is recorded, accepted ones are left to
+	// UnaryServerInterceptor.
+	entries, err := l.Query(&pb.QueryAuditLogRequest{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want only the one of AddPeer", len(entries))
+	}
+	want := &pb.AuditEntry{
+		PeerAddress: "192.0.2.1:40000",
+		Method:      "/WireGuard/AddPeer",
+		Devices:     []string{"wg0"},
+		Code:        "Unauthenticated",
+	}
+	entries[0].Time, entries[0].Request = nil, ""
+	if diff := cmp.Diff(want, entries[0], protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected entry (-want +got):\n%s", diff)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	privateKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.GenerateKey()
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{
+			name: "ImportConfig",
+			req: &pb.ImportConfigRequest{Name: "wg0", Config: "[Interface]\nPrivateKey = " + privateKey.String() +
+				"\n\n[Peer]\nPresharedKey = " + psk.String() + "\nAllowedIPs = 10.7.0.2/32"},
+			want: `{"config":"[Interface]\nPublicKey = ` + privateKey.PublicKey().String() +
+				`\n\n[Peer]\nPresharedKey = (secret)\nAllowedIPs = 10.7.0.2/32","name":"wg0"}`,
+		},
+		{
+			name: "InvalidPrivateKey",
+			req:  &pb.ConfigureDeviceRequest{Name: "wg0", Config: &pb.Config{PrivateKey: []byte{1}}},
+			want: `{"config":{"privateKey":"(secret)"},"name":"wg0"}`,
+		},
+		{
+			name: "BatchConfigure",
+			req: &pb.BatchConfigureRequest{Steps: []*pb.ConfigureDeviceRequest{
+				{Name: "wg0", Config: &pb.Config{PrivateKey: privateKey[:]}},
+			}},
+			want: `{"steps":[{"config":{"publicKey":"` + privateKey.PublicKey().String() + `"},"name":"wg0"}]}`,
+		},
+		{
+			name: "Enroll",
+			req:  &pb.EnrollRequest{Token: "one-time", Csr: []byte{1}},
+			want: `{"token":"(secret)","csr":"AQ=="}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got, want interface{}
+			if err := json.Unmarshal([]byte(summary(tc.req)), &got); err != nil {
+				t.Fatalf("summary is not JSON: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tc.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected summary (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	l := newTestLog(t)
+	start := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *timestamppb.Timestamp {
+		return timestamppb.New(start.Add(time.Duration(minutes) * time.Minute))
+	}
+	for i, devices := range [][]string{{"wg0"}, {"wg1"}, {"wg0", "wg1"}, {"wg0"}} {
+		if err := l.Write(&pb.AuditEntry{Time: at(i), Devices: devices}); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	// An entry being written is skipped.
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"time":`)
+	f.Close()
+
+	tests := []struct {
+		name  string
+		query *pb.QueryAuditLogRequest
+		want  []*timestamppb.Timestamp
+	}{
+		{name: "All", query: &pb.QueryAuditLogRequest{}, want: []*timestamppb.Timestamp{at(0), at(1), at(2), at(3)}},
+		{name: "Device", query: &pb.QueryAuditLogRequest{Name: "wg1"}, want: []*timestamppb.Timestamp{at(1), at(2)}},
+		{name: "Time", query: &pb.QueryAuditLogRequest{Since: at(1), Until: at(3)}, want: []*timestamppb.Timestamp{at(1), at(2)}},
+		{name: "Limit", query: &pb.QueryAuditLogRequest{Name: "wg0", Limit: 2}, want: []*timestamppb.Timestamp{at(2), at(3)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := l.Query(tc.query)
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+			var got []*timestamppb.Timestamp
+			for _, e := range entries {
+				got = append(got, e.GetTime())
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected entries (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := l.Query(&pb.QueryAuditLogRequest{Limit: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Query with a negative limit: want InvalidArgument, got %v", err)
+	}
+	// A line which is not an entry fails the query.
+	if err := os.WriteFile(l.path, []byte("garbage\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Query(&pb.QueryAuditLogRequest{}); err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("Query of a corrupt log: want an error for line 1, got %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+// Package authn authenticates gRPC callers by bearer tokens, as an
+// alternative to client certificates.
+package authn
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/atsevan/wireguard-grpc/server/authz"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator identifies callers by the bearer token of their
+// authorization metadata. A token is either a static API token or a JWT.
+// Callers without a token must have a client certificate.
+type Authenticator struct {
+	tokens   map[string]string
+	keys     *jose.JSONWebKeySet
+	issuer   string
+	audience string
+	public   map[string]bool
+	now      func() time.Time
+}
+
+// Option configures an Authenticator.
+type Option func(*Authenticator)
+
+// WithTokens accepts static API tokens. tokens maps the hex encoded SHA-256
+// hash of a token to the name of its owner. The caller gets the identity
+// "token:<name>".
+func WithTokens(tokens map[string]string) Option {
+	return func(a *Authenticator) {
+		a.tokens = tokens
+	}
+}
+
+// WithJWKS accepts JWTs signed by a key of keys with the issuer and
+// audience, and an expiry. The caller gets the identity "jwt:<subject>",
+// and the subject as it is if it is a SPIFFE ID.
+func WithJWKS(keys *jose.JSONWebKeySet, issuer, audience string) Option {
+	return func(a *Authenticator) {
+		a.keys = keys
+		a.issuer = issuer
+		a.audience = audience
+	}
+}
+
+// WithPublicMethods lets callers without a token or a client certificate
+// call the methods, e.g. Enroll.
+func WithPublicMethods(methods ...string) Option {
+	return func(a *Authenticator) {
+		for _, m := range methods {
+			a.public[m] = true
+		}
+	}
+}
+
+// New returns an Authenticator.
+func New(opts ...Option) *Authenticator {
+	a := &Authenticator{public: map[string]bool{}, now: time.Now}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// ReadTokens reads API tokens from a file. Each line holds the hex encoded
+// SHA-256 hash of a token followed by the name of its owner, as in
+// `echo -n $TOKEN | sha256sum`; empty lines and lines starting with # are
+// ignored.
+func ReadTokens(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseTokens(f)
+}
+
+func parseTokens(r io.Reader) (map[string]string, error) {
+	tokens := map[string]string{}
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, name, ok := strings.Cut(line, " ")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d: want \"<sha256 of the token> <name>\"", n)
+		}
+		if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("line %d: %q is not a hex encoded SHA-256 hash", n, hash)
+		}
+		tokens[strings.ToLower(hash)] = name
+	}
+	return tokens, s.Err()
+}
+
+// ReadJWKS reads a JSON Web Key Set.
+func ReadJWKS(path string) (*jose.JSONWebKeySet, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(b, keys); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("%s: no keys", path)
+	}
+	return keys, nil
+}
+
+// Authenticate adds the identities of the bearer token of the call to ctx.
+// It returns an Unauthenticated error if the token is not valid, or if
+// there is no token and no client certificate.
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		if len(authz.Identities(ctx)) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "a client certificate or a bearer token is required")
+		}
+		return ctx, nil
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be \"Bearer <token>\"")
+	}
+	ids, err := a.identify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "bearer token: %v", err)
+	}
+	return authz.WithIdentities(ctx, ids...), nil
+}
+
+// identify returns the identities of a token.
+func (a *Authenticator) identify(token string) ([]string, error) {
+	hash := sha256.Sum256([]byte(token))
+	if name, ok := a.tokens[hex.EncodeToString(hash[:])]; ok {
+		return []string{"token:" + name}, nil
+	}
+	if a.keys == nil || strings.Count(token, ".") != 2 {
+		return nil, errors.New("unknown token")
+	}
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{"jwt:" + claims.Subject}
+	if strings.HasPrefix(claims.Subject, "spiffe://") {
+		ids = append(ids, claims.Subject)
+	}
+	return ids, nil
+}
+
+// verify checks the signature and the claims of a JWT.
+func (a *Authenticator) verify(token string) (*jwt.Claims, error) {
+	tok, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, err
+	}
+	var kid string
+	if len(tok.Headers) > 0 {
+		kid = tok.Headers[0].KeyID
+	}
+	keys := a.keys.Keys
+	if kid != "" {
+		keys = a.keys.Key(kid)
+	}
+	claims := &jwt.Claims{}
+	verified := false
+	for _, key := range keys {
+		if err := tok.Claims(key, claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("no key of the JWKS verifies the signature")
+	}
+	if claims.Expiry == nil {
+		return nil, errors.New("exp claim is required")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("sub claim is required")
+	}
+	err = claims.Validate(jwt.Expected{
+		Issuer:   a.issuer,
+		Audience: jwt.Audience{a.audience},
+		Time:     a.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// UnaryServerInterceptor authenticates unary calls. It must run before the
+// interceptors relying on the identities of the caller.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streams like UnaryServerInterceptor.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.public[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
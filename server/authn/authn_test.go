@@ -0,0 +1,211 @@
+package authn
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atsevan/wireguard-grpc/server/authz"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testIssuer   = "https://issuer.example.org"
+	testAudience = "wireguard-grpc"
+)
+
+type testSigner struct {
+	t      *testing.T
+	signer jose.Signer
+}
+
+func newTestSigner(t *testing.T, kid string) (*testSigner, jose.JSONWebKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.EdDSA, Key: jose.JSONWebKey{Key: priv, KeyID: kid}},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigner{t: t, signer: signer}, jose.JSONWebKey{Key: pub, KeyID: kid, Algorithm: string(jose.EdDSA), Use: "sig"}
+}
+
+func (s *testSigner) sign(claims jwt.Claims) string {
+	s.t.Helper()
+	token, err := jwt.Signed(s.signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return token
+}
+
+func withToken(ctx context.Context, authorization string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	signer, key := newTestSigner(t, "key-1")
+	other, _ := newTestSigner(t, "key-1")
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Audience: jwt.Audience{testAudience},
+		Subject:  "deploy",
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	with := func(change func(*jwt.Claims)) jwt.Claims {
+		c := valid
+		change(&c)
+		return c
+	}
+	apiHash := sha256.Sum256([]byte("s3cret-api-token"))
+	a := New(
+		WithTokens(map[string]string{hex.EncodeToString(apiHash[:]): "ci"}),
+		WithJWKS(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key}}, testIssuer, testAudience),
+	)
+	a.now = func() time.Time { return now }
+	certCtx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+			Subject: pkix.Name{CommonName: "ops"},
+		}}}},
+	})
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "APIToken",
+			ctx:  withToken(context.Background(), "Bearer s3cret-api-token"),
+			want: []string{"token:ci"},
+		},
+		{
+			name: "JWT",
+			ctx:  withToken(context.Background(), "bearer "+signer.sign(valid)),
+			want: []string{"jwt:deploy"},
+		},
+		{
+			name: "SPIFFE",
+			ctx:  withToken(context.Background(), "Bearer "+signer.sign(with(func(c *jwt.Claims) { c.Subject = "spiffe://example.org/deploy" }))),
+			want: []string{"jwt:spiffe://example.org/deploy", "spiffe://example.org/deploy"},
+		},
+		{
+			name: "CertificateAndToken",
+			ctx:  withToken(certCtx, "Bearer s3cret-api-token"),
+			want: []string{"cn:ops", "token:ci"},
+		},
+		{
+			name: "Certificate",
+			ctx:  certCtx,
+			want: []string{"cn:ops"},
+		},
+		{
+			name:    "Anonymous",
+			ctx:     context.Background(),
+			wantErr: "a client certificate or a bearer token is required",
+		},
+		{
+			name:    "Basic",
+			ctx:     withToken(context.Background(), "Basic czNjcmV0"),
+			wantErr: `authorization must be "Bearer <token>"`,
+		},
+		{
+			name:    "UnknownToken",
+			ctx:     withToken(context.Background(), "Bearer guessed"),
+			wantErr: "unknown token",
+		},
+		{
+			name:    "OtherKey",
+			ctx:     withToken(context.Background(), "Bearer "+other.sign(valid)),
+			wantErr: "no key of the JWKS verifies the signature",
+		},
+		{
+			name:    "Expired",
+			ctx:     withToken(context.Background(), "Bearer "+signer.sign(with(func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(now.Add(-time.Hour)) }))),
+			wantErr: "token is expired",
+		},
+		{
+			name:    "NoExpiry",
+			ctx:     withToken(context.Background(), "Bearer "+signer.sign(with(func(c *jwt.Claims) { c.Expiry = nil }))),
+			wantErr: "exp claim is required",
+		},
+		{
+			name:    "Issuer",
+			ctx:     withToken(context.Background(), "Bearer "+signer.sign(with(func(c *jwt.Claims) { c.Issuer = "https://evil.example.org" }))),
+			wantErr: "invalid issuer",
+		},
+		{
+			name:    "Audience",
+			ctx:     withToken(context.Background(), "Bearer "+signer.sign(with(func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} }))),
+			wantErr: "invalid audience",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, err := a.Authenticate(tc.ctx)
+			if tc.wantErr != "" {
+				if status.Code(err) != codes.Unauthenticated || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Authenticate: want Unauthenticated containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, authz.Identities(ctx)); diff != "" {
+				t.Errorf("unexpected identities (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	hash := sha256.Sum256([]byte("token"))
+	tokens, err := parseTokens(strings.NewReader("# API tokens\n\n" + strings.ToUpper(hex.EncodeToString(hash[:])) + " ci bot\n"))
+	if err != nil {
+		t.Fatalf("parseTokens: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{hex.EncodeToString(hash[:]): "ci bot"}, tokens); diff != "" {
+		t.Errorf("unexpected tokens (-want +got):\n%s", diff)
+	}
+	for _, bad := range []string{"token ci", hex.EncodeToString(hash[:])} {
+		if _, err := parseTokens(strings.NewReader(bad)); err == nil {
+			t.Errorf("parseTokens(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestPublicMethods(t *testing.T) {
+	a := New(WithPublicMethods("/WireGuard/Enroll"))
+	interceptor := a.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/WireGuard/Enroll"}, handler); err != nil {
+		t.Errorf("Enroll without credentials: %v", err)
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/WireGuard/Devices"}, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Devices without credentials: want Unauthenticated, got %v", err)
+	}
+}
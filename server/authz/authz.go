@@ -0,0 +1,381 @@
+// Package authz authorizes gRPC calls by the identity of the caller.
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Role is a set of methods a caller may call.
+type Role string
+
+const (
+	// ReadOnly reads devices and peers, and generates keys.
+	ReadOnly Role = "read-only"
+	// PeerOperator adds, changes and removes peers on top of ReadOnly.
+	PeerOperator Role = "peer-operator"
+	// Admin calls every method and is the only role reading secrets.
+	Admin Role = "admin"
+)
+
+var (
+	readOnlyMethods = methodSet(
+		pb.WireGuard_Devices_FullMethodName,
+		pb.WireGuard_Device_FullMethodName,
+		pb.WireGuard_GetPeer_FullMethodName,
+		pb.WireGuard_ListPeers_FullMethodName,
+		pb.WireGuard_WatchDevice_FullMethodName,
+		pb.WireGuard_WatchDevices_FullMethodName,
+		pb.WireGuard_ExportConfig_FullMethodName,
+		pb.WireGuard_GeneratePeerConfig_FullMethodName,
+		pb.WireGuard_GetAddressPools_FullMethodName,
+		pb.WireGuard_PlanConfigureDevice_FullMethodName,
+		pb.WireGuard_GenerateKeyPair_FullMethodName,
+		pb.WireGuard_GeneratePresharedKey_FullMethodName,
+		pb.WireGuard_DerivePublicKey_FullMethodName,
+		wgv2.WireGuard_Devices_FullMethodName,
+		wgv2.WireGuard_Device_FullMethodName,
+		wgv2.WireGuard_GetPeer_FullMethodName,
+		wgv2.WireGuard_ListPeers_FullMethodName,
+		wgv2.WireGuard_WatchDevice_FullMethodName,
+		wgv2.WireGuard_WatchDevices_FullMethodName,
+		wgv2.WireGuard_ExportConfig_FullMethodName,
+		wgv2.WireGuard_GeneratePeerConfig_FullMethodName,
+		wgv2.WireGuard_GetAddressPools_FullMethodName,
+		wgv2.WireGuard_GenerateKeyPair_FullMethodName,
+		wgv2.WireGuard_GeneratePresharedKey_FullMethodName,
+		wgv2.WireGuard_DerivePublicKey_FullMethodName,
+		reflectionV1Alpha,
+		reflectionV1,
+	)
+	peerMethods = methodSet(
+		pb.WireGuard_AddPeer_FullMethodName,
+		pb.WireGuard_UpdatePeer_FullMethodName,
+		pb.WireGuard_RemovePeer_FullMethodName,
+		pb.WireGuard_AllocatePeer_FullMethodName,
+		pb.WireGuard_UpdateDevice_FullMethodName,
+		wgv2.WireGuard_AddPeer_FullMethodName,
+		wgv2.WireGuard_UpdatePeer_FullMethodName,
+		wgv2.WireGuard_RemovePeer_FullMethodName,
+		wgv2.WireGuard_AllocatePeer_FullMethodName,
+	)
+	// deviceIndependent methods don't touch any device, so a binding
+	// limited to devices allows them.
+	deviceIndependent = methodSet(
+		pb.WireGuard_GenerateKeyPair_FullMethodName,
+		pb.WireGuard_GeneratePresharedKey_FullMethodName,
+		pb.WireGuard_DerivePublicKey_FullMethodName,
+		wgv2.WireGuard_GenerateKeyPair_FullMethodName,
+		wgv2.WireGuard_GeneratePresharedKey_FullMethodName,
+		wgv2.WireGuard_DerivePublicKey_FullMethodName,
+		reflectionV1Alpha,
+		reflectionV1,
+	)
+	// publicMethods may be called by every client, even one without an
+	// identity: Enroll is authorized by its token.
+	publicMethods = methodSet(
+		pb.WireGuard_Enroll_FullMethodName,
+	)
+)
+
+const (
+	reflectionV1Alpha = "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+	reflectionV1      = "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+)
+
+func methodSet(methods ...string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
+
+// allows reports whether the role may call method.
+func (r Role) allows(method string) bool {
+	switch r {
+	case Admin:
+		return true
+	case PeerOperator:
+		return readOnlyMethods[method] || peerMethods[method]
+	case ReadOnly:
+		return readOnlyMethods[method]
+	}
+	return false
+}
+
+// Policy binds caller identities to roles. A call is allowed if any binding
+// of the caller allows it.
+type Policy struct {
+	Bindings []Binding `json:"bindings"`
+}
+
+// Binding grants a role to callers.
+type Binding struct {
+	// Identities are the callers the binding applies to, in the form of
+	// Identities, e.g. "cn:ops" or "spiffe://example.org/ops". "*" is
+	// every caller.
+	Identities []string `json:"identities"`
+	Role       Role     `json:"role"`
+	// Devices limits the binding to devices with a matching name, patterns
+	// as in path.Match are allowed. An empty list is every device.
+	Devices []string `json:"devices,omitempty"`
+}
+
+// Load reads a policy in JSON.
+func Load(file string) (*Policy, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	p := &Policy{}
+	if err := dec.Decode(p); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return p, nil
+}
+
+func (p *Policy) validate() error {
+	for i, b := range p.Bindings {
+		if len(b.Identities) == 0 {
+			return fmt.Errorf("bindings[%d].identities: must not be empty", i)
+		}
+		switch b.Role {
+		case ReadOnly, PeerOperator, Admin:
+		default:
+			return fmt.Errorf("bindings[%d].role: %q is not one of %s, %s or %s", i, b.Role, ReadOnly, PeerOperator, Admin)
+		}
+		for j, d := range b.Devices {
+			if _, err := path.Match(d, ""); err != nil {
+				return fmt.Errorf("bindings[%d].devices[%d]: %q: %w", i, j, d, err)
+			}
+		}
+	}
+	return nil
+}
+
+type identitiesKey struct{}
+
+// WithIdentities returns a context whose caller has the identities in
+// addition to the ones of its client certificate, e.g. the identities of a
+// bearer token.
+func WithIdentities(ctx context.Context, identities ...string) context.Context {
+	ids, _ := ctx.Value(identitiesKey{}).([]string)
+	ids = append(append([]string(nil), ids...), identities...)
+	return context.WithValue(ctx, identitiesKey{}, ids)
+}
+
+// identifier is implemented by the AuthInfo of transports identifying the
+// caller without a certificate, e.g. peercred.AuthInfo.
+type identifier interface {
+	Identities() []string
+}
+
+// Identities returns the identities of the caller from its client
+// certificate: "cn:<common name>", "dns:<DNS SAN>" and "uri:<URI SAN>".
+// SPIFFE IDs, URI SANs with the spiffe scheme, are returned as they are
+// too. Callers on other transports get the identities of their AuthInfo,
+// e.g. "uid:<uid>" on a Unix socket. They are followed by the identities
+// added by WithIdentities.
+func Identities(ctx context.Context) []string {
+	ids := peerIdentities(ctx)
+	if added, ok := ctx.Value(identitiesKey{}).([]string); ok {
+		ids = append(ids, added...)
+	}
+	return ids
+}
+
+func peerIdentities(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if info, ok := p.AuthInfo.(identifier); ok {
+		return info.Identities()
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+	cert := tlsInfo.State.PeerCertificates[0]
+	var ids []string
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, "cn:"+cert.Subject.CommonName)
+	}
+	for _, name := range cert.DNSNames {
+		ids = append(ids, "dns:"+name)
+	}
+	for _, uri := range cert.URIs {
+		ids = append(ids, "uri:"+uri.String())
+		if uri.Scheme == "spiffe" {
+			ids = append(ids, uri.String())
+		}
+	}
+	return ids
+}
+
+// Authorize returns a PermissionDenied error unless the caller with the
+// identities may call method on every device of req. Requests for secrets
+// need the admin role.
+func (p *Policy) Authorize(identities []string, method string, req interface{}) error {
+	if publicMethods[method] {
+		return nil
+	}
+	secrets := false
+	if r, ok := req.(interface{ GetIncludeSecrets() bool }); ok {
+		secrets = r.GetIncludeSecrets()
+	}
+	devices := devices(req)
+	if len(devices) == 0 {
+		// A request without a device, like Devices, reads or changes all
+		// of them.
+		devices = []string{""}
+	}
+	for _, device := range devices {
+		if !p.allows(identities, method, device, secrets) {
+			return status.Errorf(codes.PermissionDenied, "%s may not call %s%s%s", caller(identities), method, onDevice(method, device), withSecrets(secrets))
+		}
+	}
+	return nil
+}
+
+// allows reports whether a binding of the identities allows the call. An
+// empty device is every device.
+func (p *Policy) allows(identities []string, method, device string, secrets bool) bool {
+	for _, b := range p.Bindings {
+		if !b.matches(identities) || !b.Role.allows(method) || (secrets && b.Role != Admin) {
+			continue
+		}
+		if deviceIndependent[method] || b.allowsDevice(device) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Binding) allowsDevice(device string) bool {
+	if len(b.Devices) == 0 {
+		return true
+	}
+	if device == "" {
+		return false
+	}
+	for _, pattern := range b.Devices {
+		if ok, _ := path.Match(pattern, device); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Binding) matches(identities []string) bool {
+	for _, want := range b.Identities {
+		if want == "*" {
+			return true
+		}
+		for _, id := range identities {
+			if id == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func caller(identities []string) string {
+	if len(identities) == 0 {
+		return "anonymous caller"
+	}
+	return strings.Join(identities, ", ")
+}
+
+func onDevice(method, device string) string {
+	switch {
+	case deviceIndependent[method]:
+		return ""
+	case device == "":
+		return " on all devices"
+	}
+	return " on " + device
+}
+
+func withSecrets(secrets bool) string {
+	if secrets {
+		return " with secrets"
+	}
+	return ""
+}
+
+// devices returns the names of the devices a request reads or changes.
+func devices(req interface{}) []string {
+	switch r := req.(type) {
+	case *pb.BatchConfigureRequest:
+		var names []string
+		for _, step := range r.GetSteps() {
+			names = append(names, step.GetName())
+		}
+		return names
+	case interface{ GetName() string }:
+		if r.GetName() != "" {
+			return []string{r.GetName()}
+		}
+	}
+	return nil
+}
+
+// UnaryServerInterceptor authorizes unary calls with the identities of
+// their callers.
+func (p *Policy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := p.Authorize(Identities(ctx), info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes every message received on a stream,
+// the request of a server stream is only known once it is received.
+func (p *Policy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			policy:       p,
+			method:       info.FullMethod,
+			identities:   Identities(ss.Context()),
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	policy     *Policy
+	method     string
+	identities []string
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.policy.Authorize(s.identities, s.method, m)
+}
@@ -0,0 +1,294 @@
+package authz
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var testPolicy = &Policy{Bindings: []Binding{
+	{Identities: []string{"cn:admin"}, Role: Admin},
+	{Identities: []string{"spiffe://example.org/provisioner"}, Role: PeerOperator, Devices: []string{"wg-*"}},
+	{Identities: []string{"dns:monitor.example.org"}, Role: ReadOnly},
+	{Identities: []string{"cn:tenant"}, Role: Admin, Devices: []string{"wg-tenant"}},
+}}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		identities []string
+		method     string
+		req        interface{}
+		want       codes.Code
+	}{
+		{
+			name:       "Admin",
+			identities: []string{"cn:admin"},
+			method:     pb.WireGuard_DeleteDevice_FullMethodName,
+			req:        &pb.DeleteDeviceRequest{Name: "wg0"},
+		},
+		{
+			name:       "AdminSecrets",
+			identities: []string{"cn:admin"},
+			method:     pb.WireGuard_Devices_FullMethodName,
+			req:        &pb.DevicesRequest{IncludeSecrets: true},
+		},
+		{
+			name:       "PeerOperator",
+			identities: []string{"uri:spiffe://example.org/provisioner", "spiffe://example.org/provisioner"},
+			method:     wgv2.WireGuard_AddPeer_FullMethodName,
+			req:        &wgv2.AddPeerRequest{Name: "wg-office"},
+		},
+		{
+			name:       "PeerOperatorOtherDevice",
+			identities: []string{"spiffe://example.org/provisioner"},
+			method:     pb.WireGuard_AddPeer_FullMethodName,
+			req:        &pb.AddPeerRequest{Name: "wg0"},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:       "PeerOperatorConfigureDevice",
+			identities: []string{"spiffe://example.org/provisioner"},
+			method:     pb.WireGuard_ConfigureDevice_FullMethodName,
+			req:        &pb.ConfigureDeviceRequest{Name: "wg-office"},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:       "PeerOperatorAllDevices",
+			identities: []string{"spiffe://example.org/provisioner"},
+			method:     pb.WireGuard_Devices_FullMethodName,
+			req:        &pb.DevicesRequest{},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:       "PeerOperatorGenerateKeyPair",
+			identities: []string{"spiffe://example.org/provisioner"},
+			method:     pb.WireGuard_GenerateKeyPair_FullMethodName,
+			req:        &pb.GenerateKeyPairRequest{},
+		},
+		{
+			name:       "ReadOnly",
+			identities: []string{"dns:monitor.example.org"},
+			method:     pb.WireGuard_Devices_FullMethodName,
+			req:        &pb.DevicesRequest{},
+		},
+		{
+			name:       "ReadOnlySecrets",
+			identities: []string{"dns:monitor.example.org"},
+			method:     pb.WireGuard_ExportConfig_FullMethodName,
+			req:        &pb.ExportConfigRequest{Name: "wg0", IncludeSecrets: true},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:       "ReadOnlyRemovePeer",
+			identities: []string{"dns:monitor.example.org"},
+			method:     pb.WireGuard_RemovePeer_FullMethodName,
+			req:        &pb.RemovePeerRequest{Name: "wg0"},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:       "BatchOnAllowedDevices",
+			identities: []string{"cn:tenant"},
+			method:     pb.WireGuard_BatchConfigure_FullMethodName,
+			req:        &pb.BatchConfigureRequest{Steps: []*pb.ConfigureDeviceRequest{{Name: "wg-tenant"}, {Name: "wg-tenant"}}},
+		},
+		{
+			name:       "BatchOnOtherDevice",
+			identities: []string{"cn:tenant"},
+			method:     pb.WireGuard_BatchConfigure_FullMethodName,
+			req:        &pb.BatchConfigureRequest{Steps: []*pb.ConfigureDeviceRequest{{Name: "wg-tenant"}, {Name: "wg0"}}},
+			want:       codes.PermissionDenied,
+		},
+		{
+			name:   "Anonymous",
+			method: pb.WireGuard_Device_FullMethodName,
+			req:    &pb.DeviceRequest{Name: "wg0"},
+			want:   codes.PermissionDenied,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := testPolicy.Authorize(tc.identities, tc.method, tc.req)
+			if got := status.Code(err); got != tc.want {
+				t.Fatalf("Authorize: want %s, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeEveryone(t *testing.T) {
+	p := &Policy{Bindings: []Binding{{Identities: []string{"*"}, Role: ReadOnly}}}
+	if err := p.Authorize(nil, pb.WireGuard_Device_FullMethodName, &pb.DeviceRequest{Name: "wg0"}); err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	err := p.Authorize([]string{"cn:someone"}, pb.WireGuard_AddPeer_FullMethodName, &pb.AddPeerRequest{Name: "wg0"})
+	want := "cn:someone may not call /WireGuard/AddPeer on wg0"
+	if st, _ := status.FromError(err); st.Code() != codes.PermissionDenied || st.Message() != want {
+		t.Fatalf("Authorize: want PermissionDenied %q, got %v", want, err)
+	}
+}
+
+func TestAuthorizePublic(t *testing.T) {
+	p := &Policy{Bindings: []Binding{{Identities: []string{"cn:ops"}, Role: Admin}}}
+	if err := p.Authorize(nil, pb.WireGuard_Enroll_FullMethodName, &pb.EnrollRequest{}); err != nil {
+		t.Fatalf("Authorize of Enroll without an identity: %v", err)
+	}
+}
+
+// TestV2Methods checks that the roles allow every method of the v2 service
+// like the method of the same name of the original service.
+func TestV2Methods(t *testing.T) {
+	var methods []string
+	for _, m := range wgv2.WireGuard_ServiceDesc.Methods {
+		methods = append(methods, m.MethodName)
+	}
+	for _, st := range wgv2.WireGuard_ServiceDesc.Streams {
+		methods = append(methods, st.StreamName)
+	}
+	for _, name := range methods {
+		v1, v2 := "/WireGuard/"+name, "/"+wgv2.WireGuard_ServiceDesc.ServiceName+"/"+name
+		for _, set := range []map[string]bool{readOnlyMethods, peerMethods, deviceIndependent} {
+			if set[v1] != set[v2] {
+				t.Errorf("%s is classified unlike %s", v2, v1)
+			}
+		}
+	}
+}
+
+func TestIdentities(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://example.org/provisioner")
+	web, _ := url.Parse("https://example.org/ops")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+			Subject:  pkix.Name{CommonName: "ops"},
+			DNSNames: []string{"ops.example.org"},
+			URIs:     []*url.URL{spiffe, web},
+		}}}},
+	})
+	want := []string{
+		"cn:ops",
+		"dns:ops.example.org",
+		"uri:spiffe://example.org/provisioner",
+		"spiffe://example.org/provisioner",
+		"uri:https://example.org/ops",
+	}
+	if diff := cmp.Diff(want, Identities(ctx)); diff != "" {
+		t.Fatalf("unexpected identities (-want +got):\n%s", diff)
+	}
+	if got := Identities(context.Background()); got != nil {
+		t.Fatalf("Identities without a peer: %v", got)
+	}
+	local := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: testAuthInfo{"uid:1000"}})
+	if diff := cmp.Diff([]string{"uid:1000", "token:ci"}, Identities(WithIdentities(local, "token:ci"))); diff != "" {
+		t.Fatalf("unexpected identities of a local caller (-want +got):\n%s", diff)
+	}
+}
+
+// testAuthInfo identifies callers like peercred.AuthInfo.
+type testAuthInfo []string
+
+func (testAuthInfo) AuthType() string       { return "test" }
+func (a testAuthInfo) Identities() []string { return a }
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name:   "OK",
+			policy: `{"bindings": [{"identities": ["cn:ops"], "role": "peer-operator", "devices": ["wg-*"]}]}`,
+		},
+		{
+			name:    "UnknownRole",
+			policy:  `{"bindings": [{"identities": ["cn:ops"], "role": "root"}]}`,
+			wantErr: `bindings[0].role: "root" is not one of read-only, peer-operator or admin`,
+		},
+		{
+			name:    "NoIdentities",
+			policy:  `{"bindings": [{"role": "admin"}]}`,
+			wantErr: "bindings[0].identities: must not be empty",
+		},
+		{
+			name:    "BadPattern",
+			policy:  `{"bindings": [{"identities": ["*"], "role": "admin", "devices": ["wg["]}]}`,
+			wantErr: `bindings[0].devices[0]: "wg[": syntax error in pattern`,
+		},
+		{
+			name:    "UnknownField",
+			policy:  `{"bindings": [{"identity": "cn:ops", "role": "admin"}]}`,
+			wantErr: `unknown field "identity"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "policy.json")
+			if err := os.WriteFile(file, []byte(tc.policy), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(file)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Load: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("Load: want an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+
+func (s *testStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+			Subject: pkix.Name{CommonName: "tenant"},
+		}}}},
+	})
+	interceptor := testPolicy.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: pb.WireGuard_WatchDevice_FullMethodName, IsServerStream: true}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&pb.WatchDeviceRequest{})
+	}
+
+	ss := &testStream{ctx: ctx, req: &pb.WatchDeviceRequest{Name: "wg-tenant"}}
+	if err := interceptor(nil, ss, info, handler); err != nil {
+		t.Fatalf("watch an allowed device: %v", err)
+	}
+	ss = &testStream{ctx: ctx, req: &pb.WatchDeviceRequest{Name: "wg0"}}
+	if err := interceptor(nil, ss, info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("watch another device: want PermissionDenied, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"crypto"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atsevan/wireguard-grpc/server/pki"
+)
+
+const certUsage = `usage: wireguard-grpc cert <command> [flags]
+
+Commands:
+  init    create a CA in -dir
+  server  issue a server certificate with -san names
+  client  issue a client certificate with the identity -cn and -san
+  token   create a one-time token to Enroll a client certificate with an identity
+
+Run wireguard-grpc cert <command> -h for the flags of a command.
+`
+
+// sans collects repeated -san flags.
+type sans []string
+
+func (s *sans) String() string { return strings.Join(*s, ",") }
+
+func (s *sans) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// runCert runs the cert subcommand with its arguments.
+func runCert(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, certUsage)
+		return fmt.Errorf("missing command")
+	}
+	fs := flag.NewFlagSet("cert "+args[0], flag.ExitOnError)
+	dir := fs.String("dir", "certs", "directory of ca.crt, ca.key and the issued certificates")
+	alg := fs.String("alg", pki.ECDSA, "algorithm of new keys, ecdsa or ed25519")
+	var names sans
+	switch args[0] {
+	case "init":
+		cn := fs.String("cn", "wireguard-grpc CA", "common name of the CA")
+		days := fs.Int("days", 3650, "validity of the CA certificate in days")
+		fs.Parse(args[1:])
+		return initCA(*dir, *alg, *cn, days2Duration(*days))
+	case "server":
+		fs.Var(&names, "san", "DNS name or IP address of the server, repeatable; the first one is the common name")
+		name := fs.String("name", "server", "base name of the .crt and .key files")
+		days := fs.Int("days", 365, "validity of the certificate in days")
+		fs.Parse(args[1:])
+		return issue(*dir, *alg, *name, func(ca *pki.CA, pub crypto.PublicKey) (*x509.Certificate, error) {
+			return ca.IssueServer(pub, names, days2Duration(*days))
+		})
+	case "client":
+		cn := fs.String("cn", "", "common name of the client, its cn: identity")
+		fs.Var(&names, "san", "DNS name, IP address or URI like a SPIFFE ID of the client, repeatable")
+		name := fs.String("name", "client", "base name of the .crt and .key files")
+		days := fs.Int("days", 365, "validity of the certificate in days")
+		fs.Parse(args[1:])
+		return issue(*dir, *alg, *name, func(ca *pki.CA, pub crypto.PublicKey) (*x509.Certificate, error) {
+			return ca.IssueClient(pub, *cn, names, days2Duration(*days))
+		})
+	case "token":
+		cn := fs.String("cn", "", "common name of the enrolled client certificate")
+		fs.Var(&names, "san", "SAN of the enrolled client certificate, repeatable")
+		tokens := fs.String("tokens", "", "enrollment tokens file of the server's -enroll-tokens (default <dir>/enroll.json)")
+		ttl := fs.Duration("ttl", 24*time.Hour, "time until the token expires")
+		fs.Parse(args[1:])
+		if *cn == "" && len(names) == 0 {
+			return fmt.Errorf("a token needs a -cn or a -san")
+		}
+		if *tokens == "" {
+			*tokens = filepath.Join(*dir, "enroll.json")
+		}
+		token, err := pki.NewTokens(*tokens).Add(*cn, names, *ttl)
+		if err != nil {
+			return err
+		}
+		fmt.Println(token)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, certUsage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func days2Duration(days int) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// initCA writes the certificate and the key of a new CA to dir.
+func initCA(dir, alg, cn string, validity time.Duration) error {
+	key, err := pki.GenerateKey(alg)
+	if err != nil {
+		return err
+	}
+	ca, err := pki.NewCA(cn, key, validity)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return writeKeyPair(dir, "ca", ca.Cert, key)
+}
+
+// issue writes a certificate issued by the CA in dir and its new key to
+// dir/<name>.crt and dir/<name>.key.
+func issue(dir, alg, name string, issue func(*pki.CA, crypto.PublicKey) (*x509.Certificate, error)) error {
+	ca, err := pki.LoadCA(filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key"))
+	if err != nil {
+		return err
+	}
+	key, err := pki.GenerateKey(alg)
+	if err != nil {
+		return err
+	}
+	cert, err := issue(ca, key.Public())
+	if err != nil {
+		return err
+	}
+	return writeKeyPair(dir, name, cert, key)
+}
+
+func writeKeyPair(dir, name string, cert *x509.Certificate, key crypto.Signer) error {
+	keyPEM, err := pki.KeyPEM(key)
+	if err != nil {
+		return err
+	}
+	keyPath := filepath.Join(dir, name+".key")
+	if err := pki.WriteFile(keyPath, keyPEM, 0o600); err != nil {
+		return err
+	}
+	certPath := filepath.Join(dir, name+".crt")
+	if err := pki.WriteFile(certPath, pki.CertificatePEM(cert), 0o644); err != nil {
+		os.Remove(keyPath)
+		return err
+	}
+	fmt.Printf("wrote %s and %s, valid until %s\n", certPath, keyPath, cert.NotAfter.Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+	"github.com/atsevan/wireguard-grpc/server/wgserver"
+
+	"google.golang.org/grpc"
+)
+
+// mutatingMethods change devices, their peers or the state of the server,
+// or issue certificates.
+// They are recorded in the audit log.
+var mutatingMethods = []string{
+	pb.WireGuard_ConfigureDevice_FullMethodName,
+	pb.WireGuard_CreateDevice_FullMethodName,
+	pb.WireGuard_DeleteDevice_FullMethodName,
+	pb.WireGuard_AddAddress_FullMethodName,
+	pb.WireGuard_RemoveAddress_FullMethodName,
+	pb.WireGuard_SetMTU_FullMethodName,
+	pb.WireGuard_SetLinkState_FullMethodName,
+	pb.WireGuard_AddPeer_FullMethodName,
+	pb.WireGuard_UpdatePeer_FullMethodName,
+	pb.WireGuard_RemovePeer_FullMethodName,
+	pb.WireGuard_Reconcile_FullMethodName,
+	pb.WireGuard_ImportConfig_FullMethodName,
+	pb.WireGuard_SetAddressPools_FullMethodName,
+	pb.WireGuard_AllocatePeer_FullMethodName,
+	pb.WireGuard_BatchConfigure_FullMethodName,
+	pb.WireGuard_UpdateDevice_FullMethodName,
+	pb.WireGuard_Enroll_FullMethodName,
+	wgv2.WireGuard_ConfigureDevice_FullMethodName,
+	wgv2.WireGuard_CreateDevice_FullMethodName,
+	wgv2.WireGuard_DeleteDevice_FullMethodName,
+	wgv2.WireGuard_AddAddress_FullMethodName,
+	wgv2.WireGuard_RemoveAddress_FullMethodName,
+	wgv2.WireGuard_AddPeer_FullMethodName,
+	wgv2.WireGuard_UpdatePeer_FullMethodName,
+	wgv2.WireGuard_RemovePeer_FullMethodName,
+	wgv2.WireGuard_SetMTU_FullMethodName,
+	wgv2.WireGuard_SetLinkState_FullMethodName,
+	wgv2.WireGuard_Reconcile_FullMethodName,
+	wgv2.WireGuard_ImportConfig_FullMethodName,
+	wgv2.WireGuard_SetAddressPools_FullMethodName,
+	wgv2.WireGuard_AllocatePeer_FullMethodName,
+}
+
+// statusUnaryInterceptor converts errors returned by unary handlers into gRPC statuses.
+func statusUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, wgserver.StatusError(err)
+}
+
+// statusStreamInterceptor converts errors returned by stream handlers into gRPC statuses.
+func statusStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return wgserver.StatusError(handler(srv, ss))
+}
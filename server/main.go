@@ -3,21 +3,39 @@ package main
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"flag"
 	"fmt"
 	"io"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+	"github.com/atsevan/wireguard-grpc/server/audit"
+	"github.com/atsevan/wireguard-grpc/server/authn"
+	"github.com/atsevan/wireguard-grpc/server/authz"
+	"github.com/atsevan/wireguard-grpc/server/metrics"
+	"github.com/atsevan/wireguard-grpc/server/peercred"
+	"github.com/atsevan/wireguard-grpc/server/pki"
+	"github.com/atsevan/wireguard-grpc/server/tlsreload"
 	"github.com/atsevan/wireguard-grpc/server/wgserver"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var (
@@ -26,74 +44,562 @@ var (
 	certFile     = flag.String("cert", "certs/server.crt", "path to RSA certificate")
 	keyFile      = flag.String("key", "certs/server.key", "path to RSA Private key")
 	caFile       = flag.String("ca", "certs/ca.crt", "path to CA certificate")
+	tlsReloadInt = flag.Duration("tls-reload-interval", 30*time.Second, "how often to check -cert, -key and -ca for changes, 0 only reloads on SIGHUP")
 	insecureFlag = flag.Bool("insecure", false, "no credentials in use")
+	allowSecrets = flag.Bool("allow-secrets", false, "honor include_secrets requests for private and preshared keys of admins, requires -policy")
+	watchDefault = flag.Duration("watch-interval", 5*time.Second, "poll interval of watches which do not request one")
+	watchMin     = flag.Duration("watch-min-interval", time.Second, "shortest poll interval a watch may request")
+	metricsAddr  = flag.String("metrics-addr", "", "address to serve Prometheus metrics on, e.g. :9586 (disabled if empty)")
+	stateFile    = flag.String("state", "", "path to the file persisting the desired device state (disabled if empty)")
+	reconcileInt = flag.Duration("reconcile-interval", time.Minute, "how often to reconcile devices with the desired state, 0 only reconciles at start")
+	peerNames    = flag.String("peer-names", "", "path to a file of \"<public key> <name>\" lines used to label peer metrics")
+	auditLog     = flag.String("audit-log", "", "path to the append-only audit log of mutating RPCs (disabled if empty)")
+	policyFile   = flag.String("policy", "", "path to a JSON policy binding client identities to roles (every client is an admin if empty)")
+	tokensFile   = flag.String("tokens", "", "path to a file of \"<sha256 hex> <name>\" lines of accepted bearer API tokens")
+	jwksFile     = flag.String("jwks", "", "path to a JWKS file of keys verifying bearer JWTs")
+	jwtIssuer    = flag.String("jwt-issuer", "", "required iss claim of bearer JWTs")
+	jwtAudience  = flag.String("jwt-audience", "", "required aud claim of bearer JWTs")
+	caKeyFile    = flag.String("ca-key", "certs/ca.key", "path to the CA private key signing enrolled client certificates")
+	enrollTokens = flag.String("enroll-tokens", "", "path to the tokens file of \"wireguard-grpc cert token\" enabling Enroll (disabled if empty)")
+	enrollValid  = flag.Duration("enroll-validity", 365*24*time.Hour, "validity of enrolled client certificates")
+	unixSocket   = flag.String("unix-socket", "", "path to a Unix socket to serve on in addition to -host and -port, callers are identified by uid and gid and authorized by -policy, which is required (disabled if empty)")
+	unixMode     = flag.String("unix-socket-mode", "0660", "file mode of -unix-socket")
+	unixOwner    = flag.String("unix-socket-owner", "", "\"<user>[:<group>]\" owning -unix-socket (the user of the server if empty)")
 )
 
 // NodeManagerServer is a proto generated server
 type NodeManagerServer struct {
 	pb.UnimplementedWireGuardServer
-	wgs WireguardServer
+	wgs          WireguardServer
+	allowSecrets bool
+	watchDefault time.Duration
+	watchMin     time.Duration
+	audit        *audit.Log
+	enroller     *pki.Enroller
 }
 
 // WireguardServer defines an interface to the Wireguard server
 type WireguardServer interface {
 	io.Closer
-	ConfigureDevice(string, *pb.Config) error
+	ConfigureDevice(string, *pb.Config, string) error
 	Devices() ([]*pb.Device, error)
 	Device(string) (*pb.Device, error)
+	CreateDevice(string, *pb.Config) (*pb.Device, error)
+	DeleteDevice(string) error
+	AddAddress(string, *pb.IPNet) error
+	RemoveAddress(string, *pb.IPNet) error
+	SetMTU(string, int32) error
+	SetLinkState(string, bool) error
+	AddPeer(string, *pb.PeerConfig, string) (*pb.Peer, error)
+	AddGeneratedPeer(string, *pb.PeerConfig, string) (*pb.Peer, []byte, error)
+	UpdatePeer(string, *pb.PeerConfig, string) (*pb.Peer, error)
+	RemovePeer(string, []byte, string) error
+	Peer(string, []byte) (*pb.Peer, error)
+	Peers(string) ([]*pb.Peer, error)
+	WatchDevice(context.Context, string, time.Duration, func(*pb.DeviceEvent) error) error
+	WatchDevices(context.Context, time.Duration, func(*pb.DeviceEvent) error) error
+	Reconcile() ([]*pb.DeviceReconciliation, error)
+	ExportConfig(string, pb.ConfigFormat, bool) (string, error)
+	ImportConfig(string, string, string) (*pb.Device, error)
+	GeneratePeerConfig(*pb.GeneratePeerConfigRequest, bool) (string, []byte, error)
+	SetAddressPools(string, []*pb.IPNet) error
+	AddressPools(string) (*pb.AddressPools, error)
+	AllocatePeer(string, *pb.PeerConfig, bool) (*pb.Peer, []*pb.IPNet, []byte, error)
+	PlanConfigureDevice(string, *pb.Config) (*pb.DevicePlan, error)
+	BatchConfigure([]*pb.ConfigureDeviceRequest) error
+	UpdateDevice(string, []*pb.DeviceUpdate, string) (*pb.Device, error)
 }
 
 // ConfigureDevice configures a WireGuard device by its interface name.
 func (s *NodeManagerServer) ConfigureDevice(ctx context.Context, in *pb.ConfigureDeviceRequest) (*pb.ConfigureDeviceResponse, error) {
-	err := s.wgs.ConfigureDevice(in.GetName(), in.GetConfig())
+	err := s.wgs.ConfigureDevice(in.GetName(), in.GetConfig(), in.GetExpectedRevision())
 	return &pb.ConfigureDeviceResponse{}, err
 }
 
+// PlanConfigureDevice reports what ConfigureDevice would change on a
+// WireGuard device without applying it.
+func (s *NodeManagerServer) PlanConfigureDevice(ctx context.Context, in *pb.PlanConfigureDeviceRequest) (*pb.PlanConfigureDeviceResponse, error) {
+	plan, err := s.wgs.PlanConfigureDevice(in.GetName(), in.GetConfig())
+	return &pb.PlanConfigureDeviceResponse{
+		Plan: plan,
+	}, err
+}
+
+// BatchConfigure configures several WireGuard devices, restoring all of
+// them if a step fails.
+func (s *NodeManagerServer) BatchConfigure(ctx context.Context, in *pb.BatchConfigureRequest) (*pb.BatchConfigureResponse, error) {
+	err := s.wgs.BatchConfigure(in.GetSteps())
+	return &pb.BatchConfigureResponse{}, err
+}
+
+// QueryAuditLog reads the entries of the audit log matching the request.
+func (s *NodeManagerServer) QueryAuditLog(ctx context.Context, in *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	if s.audit == nil {
+		return nil, status.Error(codes.FailedPrecondition, "audit log is disabled")
+	}
+	entries, err := s.audit.Query(in)
+	return &pb.QueryAuditLogResponse{
+		Entries: entries,
+	}, err
+}
+
+// Enroll issues a client certificate for a one-time enrollment token.
+func (s *NodeManagerServer) Enroll(ctx context.Context, in *pb.EnrollRequest) (*pb.EnrollResponse, error) {
+	if s.enroller == nil {
+		return nil, status.Error(codes.FailedPrecondition, "enrollment is disabled")
+	}
+	cert, ca, err := s.enroller.Enroll(in.GetToken(), in.GetCsr())
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Enrolled a client certificate for %q, serial %x", cert.Subject, cert.SerialNumber)
+	return &pb.EnrollResponse{
+		Certificate: pki.CertificatePEM(cert),
+		Ca:          pki.CertificatePEM(ca),
+	}, nil
+}
+
+// UpdateDevice changes a WireGuard device based on its current state.
+func (s *NodeManagerServer) UpdateDevice(ctx context.Context, in *pb.UpdateDeviceRequest) (*pb.UpdateDeviceResponse, error) {
+	dev, err := s.wgs.UpdateDevice(in.GetName(), in.GetUpdates(), in.GetExpectedRevision())
+	wgserver.RedactSecrets(dev)
+	return &pb.UpdateDeviceResponse{
+		Device: dev,
+	}, err
+}
+
 // Device retrieves a WireGuard device by its interface name.
+//
+// Private and preshared keys are omitted unless include_secrets is set
+// and the caller is allowed to read secrets.
 func (s *NodeManagerServer) Device(ctx context.Context, in *pb.DeviceRequest) (*pb.DeviceResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
 	dev, err := s.wgs.Device(in.GetName())
+	if !in.GetIncludeSecrets() {
+		wgserver.RedactSecrets(dev)
+	}
 	return &pb.DeviceResponse{
 		Device: dev,
 	}, err
 }
 
 // Devices retrieves all WireGuard devices on this system.
+//
+// Private and preshared keys are omitted unless include_secrets is set
+// and the caller is allowed to read secrets.
 func (s *NodeManagerServer) Devices(ctx context.Context, in *pb.DevicesRequest) (*pb.DevicesResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
 	devices, err := s.wgs.Devices()
+	if !in.GetIncludeSecrets() {
+		for _, dev := range devices {
+			wgserver.RedactSecrets(dev)
+		}
+	}
 	return &pb.DevicesResponse{
 		Devices: devices,
 	}, err
 }
 
-func transportCredentialsFromTLS(certPath string, keyPath string, caPath string) (credentials.TransportCredentials, error) {
-	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
-	if err != nil {
-		return nil, fmt.Errorf("read RSA key pair: %w", err)
+// CreateDevice creates a WireGuard link and optionally configures it.
+func (s *NodeManagerServer) CreateDevice(ctx context.Context, in *pb.CreateDeviceRequest) (*pb.CreateDeviceResponse, error) {
+	dev, err := s.wgs.CreateDevice(in.GetName(), in.GetConfig())
+	wgserver.RedactSecrets(dev)
+	return &pb.CreateDeviceResponse{
+		Device: dev,
+	}, err
+}
+
+// DeleteDevice deletes a WireGuard link by its interface name.
+func (s *NodeManagerServer) DeleteDevice(ctx context.Context, in *pb.DeleteDeviceRequest) (*pb.DeleteDeviceResponse, error) {
+	err := s.wgs.DeleteDevice(in.GetName())
+	return &pb.DeleteDeviceResponse{}, err
+}
+
+// AddAddress assigns an IP address to a WireGuard device.
+func (s *NodeManagerServer) AddAddress(ctx context.Context, in *pb.AddAddressRequest) (*pb.AddAddressResponse, error) {
+	err := s.wgs.AddAddress(in.GetName(), in.GetAddress())
+	return &pb.AddAddressResponse{}, err
+}
+
+// RemoveAddress removes an IP address from a WireGuard device.
+func (s *NodeManagerServer) RemoveAddress(ctx context.Context, in *pb.RemoveAddressRequest) (*pb.RemoveAddressResponse, error) {
+	err := s.wgs.RemoveAddress(in.GetName(), in.GetAddress())
+	return &pb.RemoveAddressResponse{}, err
+}
+
+// SetMTU sets the MTU of a WireGuard device.
+func (s *NodeManagerServer) SetMTU(ctx context.Context, in *pb.SetMTURequest) (*pb.SetMTUResponse, error) {
+	err := s.wgs.SetMTU(in.GetName(), in.GetMtu())
+	return &pb.SetMTUResponse{}, err
+}
+
+// SetLinkState brings a WireGuard device up or down.
+func (s *NodeManagerServer) SetLinkState(ctx context.Context, in *pb.SetLinkStateRequest) (*pb.SetLinkStateResponse, error) {
+	err := s.wgs.SetLinkState(in.GetName(), in.GetUp())
+	return &pb.SetLinkStateResponse{}, err
+}
+
+// AddPeer adds a peer to a WireGuard device.
+//
+// If generate_keypair is set, the server generates the keys of the peer and
+// returns the private key.
+func (s *NodeManagerServer) AddPeer(ctx context.Context, in *pb.AddPeerRequest) (*pb.AddPeerResponse, error) {
+	if in.GetGenerateKeypair() {
+		peer, privateKey, err := s.wgs.AddGeneratedPeer(in.GetName(), in.GetPeer(), in.GetExpectedRevision())
+		wgserver.RedactPeerSecrets(peer)
+		return &pb.AddPeerResponse{
+			Peer:       peer,
+			PrivateKey: privateKey,
+		}, err
+	}
+	peer, err := s.wgs.AddPeer(in.GetName(), in.GetPeer(), in.GetExpectedRevision())
+	wgserver.RedactPeerSecrets(peer)
+	return &pb.AddPeerResponse{
+		Peer: peer,
+	}, err
+}
+
+// UpdatePeer changes an existing peer of a WireGuard device.
+func (s *NodeManagerServer) UpdatePeer(ctx context.Context, in *pb.UpdatePeerRequest) (*pb.UpdatePeerResponse, error) {
+	peer, err := s.wgs.UpdatePeer(in.GetName(), in.GetPeer(), in.GetExpectedRevision())
+	wgserver.RedactPeerSecrets(peer)
+	return &pb.UpdatePeerResponse{
+		Peer: peer,
+	}, err
+}
+
+// RemovePeer removes a peer from a WireGuard device.
+func (s *NodeManagerServer) RemovePeer(ctx context.Context, in *pb.RemovePeerRequest) (*pb.RemovePeerResponse, error) {
+	err := s.wgs.RemovePeer(in.GetName(), in.GetPublicKey(), in.GetExpectedRevision())
+	return &pb.RemovePeerResponse{}, err
+}
+
+// SetAddressPools replaces the networks peer addresses of a WireGuard device
+// are allocated from.
+func (s *NodeManagerServer) SetAddressPools(ctx context.Context, in *pb.SetAddressPoolsRequest) (*pb.SetAddressPoolsResponse, error) {
+	err := s.wgs.SetAddressPools(in.GetName(), in.GetPrefixes())
+	return &pb.SetAddressPoolsResponse{}, err
+}
+
+// GetAddressPools retrieves the address pools and leases of a WireGuard device.
+func (s *NodeManagerServer) GetAddressPools(ctx context.Context, in *pb.GetAddressPoolsRequest) (*pb.GetAddressPoolsResponse, error) {
+	pools, err := s.wgs.AddressPools(in.GetName())
+	return &pb.GetAddressPoolsResponse{
+		Pools: pools,
+	}, err
+}
+
+// AllocatePeer adds a peer with the next free addresses of the pools of a
+// WireGuard device.
+func (s *NodeManagerServer) AllocatePeer(ctx context.Context, in *pb.AllocatePeerRequest) (*pb.AllocatePeerResponse, error) {
+	peer, addrs, privateKey, err := s.wgs.AllocatePeer(in.GetName(), in.GetPeer(), in.GetGenerateKeypair())
+	wgserver.RedactPeerSecrets(peer)
+	return &pb.AllocatePeerResponse{
+		Peer:       peer,
+		Addresses:  addrs,
+		PrivateKey: privateKey,
+	}, err
+}
+
+// GetPeer retrieves a single peer of a WireGuard device by its public key.
+func (s *NodeManagerServer) GetPeer(ctx context.Context, in *pb.GetPeerRequest) (*pb.GetPeerResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
+	peer, err := s.wgs.Peer(in.GetName(), in.GetPublicKey())
+	if !in.GetIncludeSecrets() {
+		wgserver.RedactPeerSecrets(peer)
 	}
-	ca, err := os.ReadFile(caPath)
+	return &pb.GetPeerResponse{
+		Peer: peer,
+	}, err
+}
+
+// ListPeers retrieves all peers of a WireGuard device.
+func (s *NodeManagerServer) ListPeers(ctx context.Context, in *pb.ListPeersRequest) (*pb.ListPeersResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
+	peers, err := s.wgs.Peers(in.GetName())
+	if !in.GetIncludeSecrets() {
+		wgserver.RedactPeerSecrets(peers...)
+	}
+	return &pb.ListPeersResponse{
+		Peers: peers,
+	}, err
+}
+
+// WatchDevice streams the changes of a WireGuard device, starting with a snapshot.
+func (s *NodeManagerServer) WatchDevice(in *pb.WatchDeviceRequest, stream pb.WireGuard_WatchDeviceServer) error {
+	return s.wgs.WatchDevice(stream.Context(), in.GetName(), s.watchInterval(in.GetInterval()), func(ev *pb.DeviceEvent) error {
+		return stream.Send(redactEvent(ev))
+	})
+}
+
+// WatchDevices streams the changes of all WireGuard devices, starting with
+// a snapshot of every device.
+func (s *NodeManagerServer) WatchDevices(in *pb.WatchDevicesRequest, stream pb.WireGuard_WatchDevicesServer) error {
+	return s.wgs.WatchDevices(stream.Context(), s.watchInterval(in.GetInterval()), func(ev *pb.DeviceEvent) error {
+		return stream.Send(redactEvent(ev))
+	})
+}
+
+// watchInterval returns the poll interval of a watch. An unset interval
+// falls back to the default, a short one is raised to the minimum.
+func (s *NodeManagerServer) watchInterval(d *durationpb.Duration) time.Duration {
+	interval := d.AsDuration()
+	if d == nil || interval <= 0 {
+		interval = s.watchDefault
+	}
+	if interval < s.watchMin {
+		interval = s.watchMin
+	}
+	return interval
+}
+
+// redactEvent omits the secrets carried by an event.
+func redactEvent(ev *pb.DeviceEvent) *pb.DeviceEvent {
+	wgserver.RedactSecrets(ev.GetSnapshot())
+	wgserver.RedactPeerSecrets(ev.GetPeerAdded().GetPeer())
+	return ev
+}
+
+// Reconcile brings the devices back to their persisted desired state and
+// reports what had to be fixed.
+func (s *NodeManagerServer) Reconcile(ctx context.Context, in *pb.ReconcileRequest) (*pb.ReconcileResponse, error) {
+	devices, err := s.wgs.Reconcile()
+	logReconciliation(devices)
+	return &pb.ReconcileResponse{
+		Devices: devices,
+	}, err
+}
+
+// ExportConfig renders a WireGuard device as a `wg setconf` or wg-quick file.
+//
+// Private and preshared keys are omitted unless include_secrets is set
+// and the caller is allowed to read secrets.
+func (s *NodeManagerServer) ExportConfig(ctx context.Context, in *pb.ExportConfigRequest) (*pb.ExportConfigResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
+	config, err := s.wgs.ExportConfig(in.GetName(), in.GetFormat(), in.GetIncludeSecrets())
+	return &pb.ExportConfigResponse{
+		Config: config,
+	}, err
+}
+
+// ImportConfig replaces the configuration of a WireGuard device by a file.
+func (s *NodeManagerServer) ImportConfig(ctx context.Context, in *pb.ImportConfigRequest) (*pb.ImportConfigResponse, error) {
+	dev, err := s.wgs.ImportConfig(in.GetName(), in.GetConfig(), in.GetExpectedRevision())
+	wgserver.RedactSecrets(dev)
+	return &pb.ImportConfigResponse{
+		Device: dev,
+	}, err
+}
+
+// GeneratePeerConfig renders the wg-quick configuration and QR code of a client
+// connecting to an existing peer.
+//
+// A peer with a preshared key needs include_secrets, and a caller allowed
+// to read secrets.
+func (s *NodeManagerServer) GeneratePeerConfig(ctx context.Context, in *pb.GeneratePeerConfigRequest) (*pb.GeneratePeerConfigResponse, error) {
+	if err := s.checkSecrets(in.GetIncludeSecrets()); err != nil {
+		return nil, err
+	}
+	config, png, err := s.wgs.GeneratePeerConfig(in, in.GetIncludeSecrets())
+	return &pb.GeneratePeerConfigResponse{
+		Config:    config,
+		QrCodePng: png,
+	}, err
+}
+
+// GenerateKeyPair generates a private key and its public key.
+func (s *NodeManagerServer) GenerateKeyPair(ctx context.Context, in *pb.GenerateKeyPairRequest) (*pb.GenerateKeyPairResponse, error) {
+	privateKey, publicKey, err := wgserver.GenerateKeyPair()
+	return &pb.GenerateKeyPairResponse{
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}, err
+}
+
+// GeneratePresharedKey generates a preshared key.
+func (s *NodeManagerServer) GeneratePresharedKey(ctx context.Context, in *pb.GeneratePresharedKeyRequest) (*pb.GeneratePresharedKeyResponse, error) {
+	key, err := wgserver.GeneratePresharedKey()
+	return &pb.GeneratePresharedKeyResponse{
+		PresharedKey: key,
+	}, err
+}
+
+// DerivePublicKey returns the public key of a private key.
+func (s *NodeManagerServer) DerivePublicKey(ctx context.Context, in *pb.DerivePublicKeyRequest) (*pb.DerivePublicKeyResponse, error) {
+	key, err := wgserver.DerivePublicKey(in.GetPrivateKey())
+	return &pb.DerivePublicKeyResponse{
+		PublicKey: key,
+	}, err
+}
+
+// checkSecrets returns PermissionDenied if secrets are requested and the
+// server doesn't allow reading them. -allow-secrets requires a -policy,
+// which denies secrets to callers other than admins before the call gets
+// here.
+func (s *NodeManagerServer) checkSecrets(includeSecrets bool) error {
+	if includeSecrets && !s.allowSecrets {
+		return status.Error(codes.PermissionDenied, "reading secrets is not allowed")
+	}
+	return nil
+}
+
+// reloadOnSIGHUP reloads the TLS certificates whenever the server gets SIGHUP.
+func reloadOnSIGHUP(r *tlsreload.Reloader) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		if err := r.Reload(); err != nil {
+			log.Printf("Reloading TLS certificates, keeping the previous ones: %s", err)
+		}
+	}
+}
+
+// listenUnix listens on a Unix socket with the file mode, an octal string,
+// and the owner, "<user>[:<group>]".
+func listenUnix(path, mode, owner string) (net.Listener, error) {
+	perm, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || perm > 0o777 {
+		return nil, fmt.Errorf("-unix-socket-mode %q is not an octal file mode", mode)
+	}
+	uid, gid, err := peercred.ParseOwner(owner)
 	if err != nil {
-		return nil, fmt.Errorf("read CA certificate: %w", err)
+		return nil, fmt.Errorf("-unix-socket-owner: %w", err)
 	}
+	return peercred.Listen(path, os.FileMode(perm), uid, gid)
+}
 
-	// Create a certificate pool and append the client certificates from the CA
-	certPool := x509.NewCertPool()
-	if ok := certPool.AppendCertsFromPEM(ca); !ok {
-		return nil, fmt.Errorf("failed to append client certs")
+// authenticatorFromFlags returns the authenticator of bearer tokens, nil if
+// none of -tokens, -jwks and -enroll-tokens is set. Callers of Enroll need
+// no credentials.
+func authenticatorFromFlags() (*authn.Authenticator, error) {
+	var opts []authn.Option
+	if *tokensFile != "" {
+		tokens, err := authn.ReadTokens(*tokensFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, authn.WithTokens(tokens))
 	}
-	return credentials.NewTLS(&tls.Config{
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		Certificates: []tls.Certificate{certificate},
-		ClientCAs:    certPool,
-	}), nil
+	if *jwksFile != "" {
+		if *jwtIssuer == "" || *jwtAudience == "" {
+			return nil, fmt.Errorf("-jwks requires -jwt-issuer and -jwt-audience")
+		}
+		keys, err := authn.ReadJWKS(*jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, authn.WithJWKS(keys, *jwtIssuer, *jwtAudience))
+	}
+	if *enrollTokens != "" {
+		opts = append(opts, authn.WithPublicMethods(pb.WireGuard_Enroll_FullMethodName))
+	}
+	if len(opts) == 0 {
+		return nil, nil
+	}
+	return authn.New(opts...), nil
+}
+
+// reconcileEvery reconciles the devices with their desired state every interval.
+func reconcileEvery(wgs WireguardServer, interval time.Duration) {
+	for range time.Tick(interval) {
+		devices, err := wgs.Reconcile()
+		if err != nil {
+			log.Printf("Reconcile: %v", err)
+		}
+		logReconciliation(devices)
+	}
+}
+
+func logReconciliation(devices []*pb.DeviceReconciliation) {
+	for _, d := range devices {
+		for _, fix := range d.GetFixes() {
+			log.Printf("Reconcile %s: %s", d.GetName(), fix)
+		}
+		if d.GetError() != "" {
+			log.Printf("Reconcile %s failed: %s", d.GetName(), d.GetError())
+		}
+	}
+}
+
+// serveMetrics serves the device and peer statistics on /metrics.
+func serveMetrics(addr string, wgs metrics.DeviceLister, namesPath string) error {
+	var names map[string]string
+	if namesPath != "" {
+		var err error
+		names, err = metrics.ReadNames(namesPath)
+		if err != nil {
+			return fmt.Errorf("read peer names: %w", err)
+		}
+	}
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(metrics.NewCollector(wgs, names)); err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("serve metrics on %s", addr)
+	go func() {
+		log.Fatal(http.Serve(listener, mux))
+	}()
+	return nil
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "cert" {
+		if err := runCert(os.Args[2:]); err != nil {
+			log.Fatalf("cert: %v", err)
+		}
+		return
+	}
 	flag.Parse()
-	wgs, err := wgserver.NewWGServer()
+	// Without a policy every caller would read every key.
+	if *allowSecrets && *policyFile == "" {
+		log.Fatal("-allow-secrets requires -policy")
+	}
+	var opts []wgserver.Option
+	if *stateFile != "" {
+		store, err := wgserver.NewFileStore(*stateFile)
+		if err != nil {
+			log.Fatalf("state: %v", err)
+		}
+		opts = append(opts, wgserver.WithStore(store))
+	}
+	wgs, err := wgserver.NewWGServer(opts...)
 	if err != nil {
 		log.Fatalf("NewWGServer: %v", err)
 	}
 	defer wgs.Close()
+	if *stateFile != "" {
+		// Reconcile at start before serving, then in the background.
+		devices, err := wgs.Reconcile()
+		if err != nil {
+			log.Fatalf("Reconcile: %v", err)
+		}
+		logReconciliation(devices)
+		if *reconcileInt > 0 {
+			go reconcileEvery(wgs, *reconcileInt)
+		}
+	}
+
+	if *metricsAddr != "" {
+		if err := serveMetrics(*metricsAddr, wgs, *peerNames); err != nil {
+			log.Fatalf("metrics: %v", err)
+		}
+	}
 
 	addr := fmt.Sprintf("%s:%d", *host, *port)
 	listener, err := net.Listen("tcp", addr)
@@ -101,27 +607,114 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	log.Printf("listen to %s", addr)
+	var unixListener net.Listener
+	if *unixSocket != "" {
+		// Without a policy every local process which may open the
+		// socket would be an admin.
+		if *policyFile == "" {
+			log.Fatal("-unix-socket requires -policy")
+		}
+		unixListener, err = listenUnix(*unixSocket, *unixMode, *unixOwner)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		log.Printf("listen to %s", *unixSocket)
+	}
+
+	authenticator, err := authenticatorFromFlags()
+	if err != nil {
+		log.Fatalf("authentication: %v", err)
+	}
+	clientAuth := tls.RequireAndVerifyClientCert
+	if authenticator != nil {
+		// Clients without a certificate must present a bearer token or
+		// call Enroll.
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
 
 	var creds credentials.TransportCredentials
 	if *insecureFlag {
 		log.Println("No transport security in use")
 		creds = insecure.NewCredentials()
 	} else {
-		creds, err = transportCredentialsFromTLS(*certFile, *keyFile, *caFile)
+		reloader, err := tlsreload.New(*certFile, *keyFile, *caFile, clientAuth)
 		if err != nil {
 			log.Fatalf("transport credentials from TLS: %s", err)
 		}
+		go reloadOnSIGHUP(reloader)
+		if *tlsReloadInt > 0 {
+			go reloader.WatchEvery(*tlsReloadInt)
+		}
+		creds = credentials.NewTLS(reloader.ServerConfig())
 	}
 
-	opts := []grpc.ServerOption{
+	var auditLogger *audit.Log
+	if *auditLog != "" {
+		auditLogger, err = audit.Open(*auditLog)
+		if err != nil {
+			log.Fatalf("audit log: %v", err)
+		}
+		defer auditLogger.Close()
+	}
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+	if authenticator != nil {
+		// Later interceptors see the identities of the bearer token.
+		authenticate := authenticator.UnaryServerInterceptor()
+		if auditLogger != nil {
+			// Calls failing authentication never reach the audit
+			// interceptor below, record them here.
+			authenticate = auditLogger.RecordRejections(authenticate, mutatingMethods...)
+		}
+		unary = append(unary, authenticate)
+		stream = append(stream, authenticator.StreamServerInterceptor())
+	}
+	if auditLogger != nil {
+		// The audit log records the status the caller sees.
+		unary = append(unary, auditLogger.UnaryServerInterceptor(mutatingMethods...))
+	}
+	if *policyFile != "" {
+		policy, err := authz.Load(*policyFile)
+		if err != nil {
+			log.Fatalf("policy: %v", err)
+		}
+		// Calls denied by the policy are audited too, so are calls
+		// failing authentication.
+		unary = append(unary, policy.UnaryServerInterceptor())
+		stream = append(stream, policy.StreamServerInterceptor())
+	}
+	unary = append(unary, statusUnaryInterceptor)
+	stream = append(stream, statusStreamInterceptor)
+
+	if unixListener != nil {
+		creds = peercred.NewCredentials(creds)
+	}
+	serverOpts := []grpc.ServerOption{
 		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
 	}
-	s := grpc.NewServer(opts...)
+	s := grpc.NewServer(serverOpts...)
 	reflection.Register(s)
 	nms := &NodeManagerServer{
-		wgs: wgs,
+		wgs:          wgs,
+		allowSecrets: *allowSecrets,
+		watchDefault: *watchDefault,
+		watchMin:     *watchMin,
+		audit:        auditLogger,
+	}
+	if *enrollTokens != "" {
+		nms.enroller = pki.NewEnroller(pki.NewTokens(*enrollTokens), *caFile, *caKeyFile, *enrollValid)
 	}
 	pb.RegisterWireGuardServer(s, nms)
+	wgv2.RegisterWireGuardServer(s, &NodeManagerServerV2{v1: nms})
+	if unixListener != nil {
+		go func() {
+			if err := s.Serve(unixListener); err != nil {
+				log.Fatalf("failed to serve: %v", err)
+			}
+		}()
+	}
 	if err := s.Serve(listener); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
@@ -0,0 +1,129 @@
+// Package metrics exports WireGuard device and peer statistics to Prometheus.
+package metrics
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// DeviceLister retrieves the devices to export, e.g. a wgserver.WGServer.
+type DeviceLister interface {
+	Devices() ([]*pb.Device, error)
+}
+
+var (
+	peerLabels = []string{"device", "public_key", "name"}
+
+	devicePeersDesc = prometheus.NewDesc(
+		"wireguard_device_peers",
+		"Number of peers configured on the device.",
+		[]string{"device"}, nil)
+	receiveBytesDesc = prometheus.NewDesc(
+		"wireguard_peer_receive_bytes_total",
+		"Bytes received from the peer.",
+		peerLabels, nil)
+	transmitBytesDesc = prometheus.NewDesc(
+		"wireguard_peer_transmit_bytes_total",
+		"Bytes transmitted to the peer.",
+		peerLabels, nil)
+	lastHandshakeDesc = prometheus.NewDesc(
+		"wireguard_peer_last_handshake_seconds",
+		"Seconds since the last handshake with the peer. Absent if there was none.",
+		peerLabels, nil)
+	allowedIPsDesc = prometheus.NewDesc(
+		"wireguard_peer_allowed_ips",
+		"Number of allowed IPs of the peer.",
+		peerLabels, nil)
+)
+
+// Collector is a prometheus.Collector which reads the devices on every scrape.
+type Collector struct {
+	devices DeviceLister
+	names   map[string]string
+	now     func() time.Time
+}
+
+// NewCollector returns a Collector for the devices. names maps base64 encoded
+// public keys to friendly peer names and may be nil.
+func NewCollector(devices DeviceLister, names map[string]string) *Collector {
+	return &Collector{
+		devices: devices,
+		names:   names,
+		now:     time.Now,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- devicePeersDesc
+	ch <- receiveBytesDesc
+	ch <- transmitBytesDesc
+	ch <- lastHandshakeDesc
+	ch <- allowedIPsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	devices, err := c.devices.Devices()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(devicePeersDesc, fmt.Errorf("devices: %w", err))
+		return
+	}
+	now := c.now()
+	for _, dev := range devices {
+		ch <- prometheus.MustNewConstMetric(devicePeersDesc, prometheus.GaugeValue, float64(len(dev.GetPeers())), dev.GetName())
+		for _, p := range dev.GetPeers() {
+			key := base64.StdEncoding.EncodeToString(p.GetPublicKey())
+			labels := []string{dev.GetName(), key, c.names[key]}
+			ch <- prometheus.MustNewConstMetric(receiveBytesDesc, prometheus.CounterValue, float64(p.GetRecievedBytes()), labels...)
+			ch <- prometheus.MustNewConstMetric(transmitBytesDesc, prometheus.CounterValue, float64(p.GetTransmitBytes()), labels...)
+			ch <- prometheus.MustNewConstMetric(allowedIPsDesc, prometheus.GaugeValue, float64(len(p.GetAllowedIps())), labels...)
+			if hs := p.GetLastHandshakeTime(); hs != nil && !hs.AsTime().IsZero() {
+				ch <- prometheus.MustNewConstMetric(lastHandshakeDesc, prometheus.GaugeValue, now.Sub(hs.AsTime()).Seconds(), labels...)
+			}
+		}
+	}
+}
+
+// ReadNames reads friendly peer names from a file. Each line holds a base64
+// encoded public key followed by the name; empty lines and lines starting
+// with # are ignored.
+func ReadNames(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseNames(f)
+}
+
+func parseNames(r io.Reader) (map[string]string, error) {
+	names := map[string]string{}
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, name, ok := strings.Cut(line, " ")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d: want \"<public key> <name>\"", n)
+		}
+		if _, err := wgtypes.ParseKey(key); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		names[key] = name
+	}
+	return names, s.Err()
+}
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testLister struct {
+	devices []*pb.Device
+	err     error
+}
+
+func (l testLister) Devices() ([]*pb.Device, error) {
+	return l.devices, l.err
+}
+
+func TestCollector(t *testing.T) {
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	key1 := make([]byte, 32)
+	key2 := append(make([]byte, 31), 1)
+	devices := []*pb.Device{{
+		Name: "wg0",
+		Peers: []*pb.Peer{
+			{
+				PublicKey:         key1,
+				LastHandshakeTime: timestamppb.New(now.Add(-90 * time.Second)),
+				RecievedBytes:     100,
+				TransmitBytes:     200,
+				AllowedIps:        []*pb.IPNet{{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}},
+			},
+			{
+				PublicKey:         key2,
+				LastHandshakeTime: timestamppb.New(time.Time{}),
+			},
+		},
+	}}
+	c := NewCollector(testLister{devices: devices}, map[string]string{
+		"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=": "laptop",
+	})
+	c.now = func() time.Time { return now }
+
+	want := `
+# HELP wireguard_device_peers Number of peers configured on the device.
+# TYPE wireguard_device_peers gauge
+wireguard_device_peers{device="wg0"} 2
+# HELP wireguard_peer_allowed_ips Number of allowed IPs of the peer.
+# TYPE wireguard_peer_allowed_ips gauge
+wireguard_peer_allowed_ips{device="wg0",name="laptop",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="} 1
+wireguard_peer_allowed_ips{device="wg0",name="",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE="} 0
+# HELP wireguard_peer_last_handshake_seconds Seconds since the last handshake with the peer. Absent if there was none.
+# TYPE wireguard_peer_last_handshake_seconds gauge
+wireguard_peer_last_handshake_seconds{device="wg0",name="laptop",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="} 90
+# HELP wireguard_peer_receive_bytes_total Bytes received from the peer.
+# TYPE wireguard_peer_receive_bytes_total counter
+wireguard_peer_receive_bytes_total{device="wg0",name="laptop",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="} 100
+wireguard_peer_receive_bytes_total{device="wg0",name="",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE="} 0
+# HELP wireguard_peer_transmit_bytes_total Bytes transmitted to the peer.
+# TYPE wireguard_peer_transmit_bytes_total counter
+wireguard_peer_transmit_bytes_total{device="wg0",name="laptop",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="} 200
+wireguard_peer_transmit_bytes_total{device="wg0",name="",public_key="AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE="} 0
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Fatal(err)
+	}
+
+	c = NewCollector(testLister{err: errors.New("netlink failure")}, nil)
+	if err := testutil.CollectAndCompare(c, strings.NewReader("")); err == nil {
+		t.Fatal("want an error from a failing DeviceLister")
+	}
+}
+
+func TestParseNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  map[string]string
+		err   string
+	}{
+		{
+			name:  "names",
+			input: "# peers\n\nAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA= laptop of alex\n",
+			want:  map[string]string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=": "laptop of alex"},
+		},
+		{
+			name:  "missing name",
+			input: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=\n",
+			err:   `line 1: want "<public key> <name>"`,
+		},
+		{
+			name:  "bad key",
+			input: "# peers\nnot-a-key laptop\n",
+			err:   "line 2: wgtypes: failed to parse base64-encoded key: illegal base64 data at input byte 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNames(strings.NewReader(tt.input))
+			if err != nil || tt.err != "" {
+				if diff := cmp.Diff(tt.err, err.Error()); diff != "" {
+					t.Fatalf("unexpected error (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("unexpected names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
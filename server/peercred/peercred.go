@@ -0,0 +1,151 @@
+// Package peercred serves gRPC on Unix sockets and identifies callers by
+// the credentials of their process, so local tools need no certificate.
+package peercred
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// ErrUnsupported is returned on platforms without peer credentials.
+var ErrUnsupported = errors.New("peer credentials are not supported on this platform")
+
+// AuthInfo holds the credentials of the process connected to a Unix socket
+// when it connected.
+type AuthInfo struct {
+	credentials.CommonAuthInfo
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AuthType implements credentials.AuthInfo.
+func (AuthInfo) AuthType() string {
+	return "peercred"
+}
+
+// Identities returns the identities of the process: "uid:<uid>" and
+// "gid:<gid>", followed by "user:<name>" and "group:<name>" if the ids
+// have names.
+func (a AuthInfo) Identities() []string {
+	uid := strconv.FormatUint(uint64(a.UID), 10)
+	gid := strconv.FormatUint(uint64(a.GID), 10)
+	ids := []string{"uid:" + uid, "gid:" + gid}
+	if u, err := user.LookupId(uid); err == nil {
+		ids = append(ids, "user:"+u.Username)
+	}
+	if g, err := user.LookupGroupId(gid); err == nil {
+		ids = append(ids, "group:"+g.Name)
+	}
+	return ids
+}
+
+type transportCredentials struct {
+	credentials.TransportCredentials
+}
+
+// NewCredentials returns server credentials which authenticate connections
+// to Unix sockets by peer credentials and hand every other connection to
+// creds, e.g. TLS credentials of a TCP listener.
+func NewCredentials(creds credentials.TransportCredentials) credentials.TransportCredentials {
+	return &transportCredentials{TransportCredentials: creds}
+}
+
+func (c *transportCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return c.TransportCredentials.ServerHandshake(conn)
+	}
+	info, err := readCredentials(uc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("peer credentials: %w", err)
+	}
+	// Nothing leaves the host, like with the local credentials of gRPC.
+	info.SecurityLevel = credentials.PrivacyAndIntegrity
+	return conn, info, nil
+}
+
+func (c *transportCredentials) Clone() credentials.TransportCredentials {
+	return &transportCredentials{TransportCredentials: c.TransportCredentials.Clone()}
+}
+
+// Listen listens on a Unix socket at path with the file mode. The socket is
+// owned by uid and gid, -1 keeps the id of the server. A socket left over
+// at path is removed, any other file is not.
+func Listen(path string, mode os.FileMode, uid, gid int) (net.Listener, error) {
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		l.Close()
+		return nil, err
+	}
+	if uid != -1 || gid != -1 {
+		if err := os.Chown(path, uid, gid); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// ParseOwner parses "<user>[:<group>]" into ids. User and group are names
+// or numeric ids, an empty one is returned as -1.
+func ParseOwner(owner string) (uid, gid int, err error) {
+	userName, groupName, _ := strings.Cut(owner, ":")
+	uid, gid = -1, -1
+	if userName != "" {
+		if uid, err = lookupID(userName, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		}); err != nil {
+			return -1, -1, err
+		}
+	}
+	if groupName != "" {
+		if gid, err = lookupID(groupName, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		}); err != nil {
+			return -1, -1, err
+		}
+	}
+	return uid, gid, nil
+}
+
+// lookupID returns name as it is if it is numeric, or the id lookup finds.
+func lookupID(name string, lookup func(string) (string, error)) (int, error) {
+	id := name
+	if _, err := strconv.ParseUint(name, 10, 32); err != nil {
+		if id, err = lookup(name); err != nil {
+			return -1, err
+		}
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return -1, fmt.Errorf("%s has the non-numeric id %q", name, id)
+	}
+	return n, nil
+}
@@ -0,0 +1,29 @@
+//go:build linux
+
+package peercred
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// readCredentials reads the credentials of the peer with SO_PEERCRED.
+func readCredentials(conn *net.UnixConn) (AuthInfo, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return AuthInfo{}, err
+	}
+	var ucred *unix.Ucred
+	var serr error
+	err = raw.Control(func(fd uintptr) {
+		ucred, serr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil {
+		return AuthInfo{}, err
+	}
+	if serr != nil {
+		return AuthInfo{}, serr
+	}
+	return AuthInfo{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
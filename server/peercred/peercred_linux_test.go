@@ -0,0 +1,49 @@
+package peercred
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestServerHandshake(t *testing.T) {
+	l, err := Listen(filepath.Join(t.TempDir(), "wg.sock"), 0o600, -1, -1)
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer l.Close()
+	client, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	creds := NewCredentials(insecure.NewCredentials())
+	_, info, err := creds.ServerHandshake(conn)
+	if err != nil {
+		t.Fatalf("ServerHandshake: %v", err)
+	}
+	got, ok := info.(AuthInfo)
+	if !ok {
+		t.Fatalf("ServerHandshake returned %T, want AuthInfo", info)
+	}
+	if int(got.PID) != os.Getpid() || int(got.UID) != os.Getuid() || int(got.GID) != os.Getgid() {
+		t.Errorf("got credentials pid=%d uid=%d gid=%d, want %d, %d and %d", got.PID, got.UID, got.GID, os.Getpid(), os.Getuid(), os.Getgid())
+	}
+
+	// Other connections are handed to the wrapped credentials.
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+	if _, info, err := creds.ServerHandshake(a); err != nil || info.AuthType() != "insecure" {
+		t.Errorf("ServerHandshake of another connection = %v, %v; want insecure", info, err)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package peercred
+
+import "net"
+
+func readCredentials(conn *net.UnixConn) (AuthInfo, error) {
+	return AuthInfo{}, ErrUnsupported
+}
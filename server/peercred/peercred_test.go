@@ -0,0 +1,77 @@
+package peercred
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wg.sock")
+	l, err := Listen(path, 0o600, -1, -1)
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode()&os.ModeSocket == 0 || fi.Mode().Perm() != 0o600 {
+		t.Errorf("the socket has the mode %s, want a socket with -rw-------", fi.Mode())
+	}
+
+	// A socket left over by a previous server is replaced.
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	l.Close()
+	l, err = Listen(path, 0o660, -1, -1)
+	if err != nil {
+		t.Fatalf("Listen on a left over socket: %v", err)
+	}
+	l.Close()
+
+	file := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Listen(file, 0o600, -1, -1); err == nil || !strings.Contains(err.Error(), "is not a socket") {
+		t.Errorf("Listen on a regular file: want an error, got %v", err)
+	}
+}
+
+func TestIdentities(t *testing.T) {
+	ids := AuthInfo{UID: 4242, GID: 4343}.Identities()
+	if diff := cmp.Diff([]string{"uid:4242", "gid:4343"}, ids[:2]); diff != "" {
+		t.Errorf("unexpected identities (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseOwner(t *testing.T) {
+	tests := []struct {
+		owner    string
+		uid, gid int
+		wantErr  bool
+	}{
+		{owner: "", uid: -1, gid: -1},
+		{owner: "1000", uid: 1000, gid: -1},
+		{owner: "1000:1001", uid: 1000, gid: 1001},
+		{owner: ":1001", uid: -1, gid: 1001},
+		{owner: "no-such-user-4242", wantErr: true},
+		{owner: ":no-such-group-4242", wantErr: true},
+	}
+	for _, tc := range tests {
+		uid, gid, err := ParseOwner(tc.owner)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseOwner(%q) = %d, %d; want an error", tc.owner, uid, gid)
+			}
+			continue
+		}
+		if err != nil || uid != tc.uid || gid != tc.gid {
+			t.Errorf("ParseOwner(%q) = %d, %d, %v; want %d, %d", tc.owner, uid, gid, err, tc.uid, tc.gid)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package pki
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Enrollment is a one-time token for a client certificate with an identity.
+type Enrollment struct {
+	// Hash is the hex encoded SHA-256 hash of the token.
+	Hash       string    `json:"sha256"`
+	CommonName string    `json:"common_name,omitempty"`
+	SANs       []string  `json:"sans,omitempty"`
+	Expires    time.Time `json:"expires"`
+}
+
+// Tokens is a JSON file of the enrollments which have not been redeemed.
+// Changes of the file are serialized by a lock file next to it, so tokens
+// may be added while the server redeems others. Where file locks are not
+// supported, only add tokens while the server is stopped.
+type Tokens struct {
+	path string
+	now  func() time.Time
+	// mu serializes the changes of the file within the process.
+	mu sync.Mutex
+}
+
+// NewTokens returns the tokens of the file at path, which is created on the
+// first Add.
+func NewTokens(path string) *Tokens {
+	return &Tokens{path: path, now: time.Now}
+}
+
+// Add creates a token for a client certificate with the common name and
+// sans which expires after ttl.
+func (t *Tokens) Add(commonName string, sans []string, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	unlock, err := t.lock()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	enrollments, err := t.read()
+	if err != nil {
+		return "", err
+	}
+	enrollments = append(t.unexpired(enrollments), Enrollment{
+		Hash:       hashToken(token),
+		CommonName: commonName,
+		SANs:       sans,
+		Expires:    t.now().Add(ttl).UTC().Truncate(time.Second),
+	})
+	if err := t.write(enrollments); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Redeem calls use with the enrollment of token and removes it unless use
+// fails, so a failed enrollment doesn't use up the token. A token which is
+// unknown, expired or redeemed already is denied with PermissionDenied.
+func (t *Tokens) Redeem(token string, use func(*Enrollment) error) error {
+	unlock, err := t.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	enrollments, err := t.read()
+	if err != nil {
+		return err
+	}
+	enrollments = t.unexpired(enrollments)
+	hash := hashToken(token)
+	for i, e := range enrollments {
+		if e.Hash == hash {
+			if err := use(&e); err != nil {
+				return err
+			}
+			return t.write(append(enrollments[:i:i], enrollments[i+1:]...))
+		}
+	}
+	return status.Error(codes.PermissionDenied, "the enrollment token is unknown, expired or used")
+}
+
+// lock serializes the changes of the file with other processes, like the
+// cert subcommand adding tokens, and returns the function releasing it.
+func (t *Tokens) lock() (func(), error) {
+	t.mu.Lock()
+	unlockFile, err := lockFile(t.path + ".lock")
+	if err != nil {
+		t.mu.Unlock()
+		return nil, err
+	}
+	return func() {
+		unlockFile()
+		t.mu.Unlock()
+	}, nil
+}
+
+func (t *Tokens) unexpired(enrollments []Enrollment) []Enrollment {
+	now := t.now()
+	var kept []Enrollment
+	for _, e := range enrollments {
+		if now.Before(e.Expires) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+func (t *Tokens) read() ([]Enrollment, error) {
+	b, err := os.ReadFile(t.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var enrollments []Enrollment
+	if err := json.Unmarshal(b, &enrollments); err != nil {
+		return nil, err
+	}
+	return enrollments, nil
+}
+
+// write replaces the file atomically, so a crash never loses a token.
+func (t *Tokens) write(enrollments []Enrollment) error {
+	if enrollments == nil {
+		enrollments = []Enrollment{}
+	}
+	b, err := json.MarshalIndent(enrollments, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(t.path), filepath.Base(t.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), t.path)
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// Enroller issues client certificates for enrollment tokens.
+type Enroller struct {
+	tokens   *Tokens
+	caCert   string
+	caKey    string
+	validity time.Duration
+}
+
+// NewEnroller returns an Enroller signing with the CA of the files. They
+// are read on every enrollment, so a renewed CA is picked up.
+func NewEnroller(tokens *Tokens, caCert, caKey string, validity time.Duration) *Enroller {
+	return &Enroller{tokens: tokens, caCert: caCert, caKey: caKey, validity: validity}
+}
+
+// Enroll redeems token and issues a client certificate for the public key
+// of csr, a PEM or DER encoded certificate signing request. It returns the
+// certificate and the CA certificate. The token is only used up once the
+// certificate is issued.
+func (e *Enroller) Enroll(token string, csr []byte) (*x509.Certificate, *x509.Certificate, error) {
+	if token == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "token: must not be empty")
+	}
+	if block, _ := pem.Decode(csr); block != nil {
+		csr = block.Bytes
+	}
+	req, err := x509.ParseCertificateRequest(csr)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "csr: %v", err)
+	}
+	if err := req.CheckSignature(); err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "csr: %v", err)
+	}
+	ca, err := LoadCA(e.caCert, e.caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	var cert *x509.Certificate
+	err = e.tokens.Redeem(token, func(enrollment *Enrollment) error {
+		var err error
+		cert, err = ca.IssueClient(req.PublicKey, enrollment.CommonName, enrollment.SANs, e.validity)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, ca.Cert, nil
+}
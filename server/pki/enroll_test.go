@@ -0,0 +1,112 @@
+package pki
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokens(t *testing.T) {
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	tokens := NewTokens(filepath.Join(t.TempDir(), "enroll.json"))
+	tokens.now = func() time.Time { return now }
+
+	token, err := tokens.Add("ops", []string{"spiffe://example.org/ops"}, time.Hour)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	expiring, err := tokens.Add("monitor", nil, time.Minute)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	var got *Enrollment
+	use := func(e *Enrollment) error {
+		got = e
+		return nil
+	}
+	if err := tokens.Redeem("guessed", use); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Redeem of an unknown token: want PermissionDenied, got %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := tokens.Redeem(expiring, use); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Redeem of an expired token: want PermissionDenied, got %v", err)
+	}
+	// A failed use keeps the token.
+	failed := errors.New("signing failed")
+	if err := tokens.Redeem(token, func(*Enrollment) error { return failed }); err != failed {
+		t.Fatalf("Redeem with a failing use: want %v, got %v", failed, err)
+	}
+	if err := tokens.Redeem(token, use); err != nil {
+		t.Fatalf("Redeem: %v", err)
+	}
+	want := &Enrollment{
+		Hash:       hashToken(token),
+		CommonName: "ops",
+		SANs:       []string{"spiffe://example.org/ops"},
+		Expires:    time.Date(2023, 7, 1, 13, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected enrollment (-want +got):\n%s", diff)
+	}
+	if err := tokens.Redeem(token, use); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("second Redeem of a token: want PermissionDenied, got %v", err)
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, ECDSA)
+	caKey, _ := KeyPEM(ca.Key())
+	caCert, caKeyPath := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	if err := WriteFile(caCert, CertificatePEM(ca.Cert), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(caKeyPath, caKey, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tokens := NewTokens(filepath.Join(dir, "enroll.json"))
+	token, err := tokens.Add("ops", []string{"ops.example.org"}, time.Hour)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	e := NewEnroller(tokens, caCert, caKeyPath, 24*time.Hour)
+
+	key, _ := GenerateKey(Ed25519)
+	// The CSR asks for another identity, the one of the token wins.
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "admin"},
+		DNSNames: []string{"admin.example.org"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.Enroll(token, []byte("not a CSR")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Enroll with an invalid CSR: want InvalidArgument, got %v", err)
+	}
+	cert, gotCA, err := e.Enroll(token, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+	if err != nil {
+		t.Fatalf("Enroll: %v", err)
+	}
+	if !gotCA.Equal(ca.Cert) {
+		t.Errorf("Enroll returned another CA certificate")
+	}
+	if cert.Subject.CommonName != "ops" || !cmp.Equal(cert.DNSNames, []string{"ops.example.org"}) {
+		t.Errorf("the certificate is issued for %s %v, want ops [ops.example.org]", cert.Subject.CommonName, cert.DNSNames)
+	}
+	if !cmp.Equal(cert.PublicKey, key.Public()) {
+		t.Errorf("the certificate is not issued for the key of the CSR")
+	}
+	if _, _, err := e.Enroll(token, der); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Enroll with a used token: want PermissionDenied, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package pki
+
+// lockFile does nothing, only the changes within a process are serialized.
+func lockFile(path string) (func(), error) {
+	return func() {}, nil
+}
@@ -0,0 +1,23 @@
+//go:build unix
+
+package pki
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock of the file at path, which is created if
+// needed, and returns the function releasing it.
+func lockFile(path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+	// Closing the file releases the lock.
+	return func() { f.Close() }, nil
+}
@@ -0,0 +1,209 @@
+// Package pki is a small certificate authority issuing the server and
+// client certificates of mutual TLS.
+package pki
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Key algorithms of GenerateKey.
+const (
+	ECDSA   = "ecdsa"
+	Ed25519 = "ed25519"
+)
+
+// GenerateKey generates an ECDSA P-256 or an Ed25519 private key.
+func GenerateKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case ECDSA:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case Ed25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("unknown key algorithm %q, want %s or %s", alg, ECDSA, Ed25519)
+	}
+}
+
+// CA issues certificates signed by its key.
+type CA struct {
+	Cert *x509.Certificate
+	key  crypto.Signer
+	now  func() time.Time
+}
+
+// NewCA returns a CA with a new self-signed certificate for key.
+func NewCA(commonName string, key crypto.Signer, validity time.Duration) (*CA, error) {
+	ca := &CA{key: key, now: time.Now}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := ca.now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	ca.Cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// LoadCA reads the certificate and the private key of a CA from PEM files.
+func LoadCA(certPath, keyPath string) (*CA, error) {
+	pair, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("read CA key pair: %w", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse CA certificate: %w", err)
+	}
+	if !cert.IsCA {
+		return nil, fmt.Errorf("%s is not a CA certificate", certPath)
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a signing key", keyPath)
+	}
+	return &CA{Cert: cert, key: key, now: time.Now}, nil
+}
+
+// Key returns the private key of the CA.
+func (ca *CA) Key() crypto.Signer {
+	return ca.key
+}
+
+// IssueServer issues a server certificate for pub, valid for validity but
+// not after the CA expires. sans are DNS names and
+// IP addresses; the first one is the common name.
+func (ca *CA) IssueServer(pub crypto.PublicKey, sans []string, validity time.Duration) (*x509.Certificate, error) {
+	if len(sans) == 0 {
+		return nil, fmt.Errorf("a server certificate needs a DNS name or an IP address")
+	}
+	return ca.issue(pub, sans[0], sans, x509.ExtKeyUsageServerAuth, validity)
+}
+
+// IssueClient issues a client certificate for pub like IssueServer, with
+// the common name and the sans: DNS names, IP addresses and URIs like
+// SPIFFE IDs.
+func (ca *CA) IssueClient(pub crypto.PublicKey, commonName string, sans []string, validity time.Duration) (*x509.Certificate, error) {
+	if commonName == "" && len(sans) == 0 {
+		return nil, fmt.Errorf("a client certificate needs a common name or a SAN")
+	}
+	return ca.issue(pub, commonName, sans, x509.ExtKeyUsageClientAuth, validity)
+}
+
+func (ca *CA) issue(pub crypto.PublicKey, commonName string, sans []string, usage x509.ExtKeyUsage, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := ca.now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	if _, isRSA := pub.(*rsa.PublicKey); isRSA {
+		// RSA keys encrypt the TLS 1.2 key exchange.
+		tmpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+	if tmpl.NotAfter.After(ca.Cert.NotAfter) {
+		// A certificate cannot be verified after its CA expired.
+		tmpl.NotAfter = ca.Cert.NotAfter
+	}
+	if err := addSANs(tmpl, sans); err != nil {
+		return nil, err
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.Cert, pub, ca.key)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// addSANs sorts sans into IP addresses, URIs and DNS names.
+func addSANs(tmpl *x509.Certificate, sans []string) error {
+	for _, san := range sans {
+		switch {
+		case net.ParseIP(san) != nil:
+			tmpl.IPAddresses = append(tmpl.IPAddresses, net.ParseIP(san))
+		case strings.Contains(san, "://"):
+			u, err := url.Parse(san)
+			if err != nil {
+				return fmt.Errorf("SAN %q: %w", san, err)
+			}
+			tmpl.URIs = append(tmpl.URIs, u)
+		case san != "" && !strings.ContainsAny(san, " /:@"):
+			tmpl.DNSNames = append(tmpl.DNSNames, san)
+		default:
+			return fmt.Errorf("SAN %q is not a DNS name, an IP address or a URI", san)
+		}
+	}
+	return nil
+}
+
+// serialNumber returns a random 128 bit serial number.
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// CertificatePEM encodes a certificate in PEM.
+func CertificatePEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// KeyPEM encodes a private key in PKCS #8 PEM.
+func KeyPEM(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// WriteFile writes a new file with the permissions, it never overwrites an
+// existing one.
+func WriteFile(path string, b []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,141 @@
+package pki
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestCA(t *testing.T, alg string) *CA {
+	t.Helper()
+	key, err := GenerateKey(alg)
+	if err != nil {
+		t.Fatalf("GenerateKey(%s): %v", alg, err)
+	}
+	ca, err := NewCA("test CA", key, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewCA: %v", err)
+	}
+	return ca
+}
+
+func TestIssue(t *testing.T) {
+	for _, alg := range []string{ECDSA, Ed25519} {
+		t.Run(alg, func(t *testing.T) {
+			ca := newTestCA(t, alg)
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.Cert)
+			key, _ := GenerateKey(alg)
+
+			server, err := ca.IssueServer(key.Public(), []string{"wg.example.org", "127.0.0.1"}, time.Hour)
+			if err != nil {
+				t.Fatalf("IssueServer: %v", err)
+			}
+			if _, err := server.Verify(x509.VerifyOptions{DNSName: "wg.example.org", Roots: roots}); err != nil {
+				t.Errorf("the server certificate does not verify: %v", err)
+			}
+			if _, err := server.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err == nil {
+				t.Errorf("the server certificate verifies as a client certificate")
+			}
+
+			client, err := ca.IssueClient(key.Public(), "ops", []string{"spiffe://example.org/ops", "ops.example.org"}, 48*time.Hour)
+			if err != nil {
+				t.Fatalf("IssueClient: %v", err)
+			}
+			if _, err := client.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+				t.Errorf("the client certificate does not verify: %v", err)
+			}
+			got := []string{client.Subject.CommonName, client.URIs[0].String(), client.DNSNames[0]}
+			if diff := cmp.Diff([]string{"ops", "spiffe://example.org/ops", "ops.example.org"}, got); diff != "" {
+				t.Errorf("unexpected identity (-want +got):\n%s", diff)
+			}
+			if client.NotAfter.After(ca.Cert.NotAfter) {
+				t.Errorf("the client certificate expires %s after the CA %s", client.NotAfter, ca.Cert.NotAfter)
+			}
+		})
+	}
+}
+
+func TestIssueInvalid(t *testing.T) {
+	ca := newTestCA(t, ECDSA)
+	key, _ := GenerateKey(ECDSA)
+	tests := []struct {
+		name    string
+		issue   func() error
+		wantErr string
+	}{
+		{
+			name: "ServerWithoutSANs",
+			issue: func() error {
+				_, err := ca.IssueServer(key.Public(), nil, time.Hour)
+				return err
+			},
+			wantErr: "needs a DNS name or an IP address",
+		},
+		{
+			name: "ClientWithoutIdentity",
+			issue: func() error {
+				_, err := ca.IssueClient(key.Public(), "", nil, time.Hour)
+				return err
+			},
+			wantErr: "needs a common name or a SAN",
+		},
+		{
+			name: "InvalidSAN",
+			issue: func() error {
+				_, err := ca.IssueClient(key.Public(), "ops", []string{"ops@example.org"}, time.Hour)
+				return err
+			},
+			wantErr: `SAN "ops@example.org" is not a DNS name, an IP address or a URI`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.issue(); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("want an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+	if _, err := GenerateKey("rsa"); err == nil {
+		t.Errorf("GenerateKey(rsa) succeeded")
+	}
+}
+
+func TestLoadCA(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, Ed25519)
+	keyPEM, err := KeyPEM(ca.Key())
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPath, keyPath := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	if err := WriteFile(certPath, CertificatePEM(ca.Cert), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(keyPath, keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(keyPath, keyPEM, 0o600); err == nil {
+		t.Errorf("WriteFile overwrote %s", keyPath)
+	}
+
+	loaded, err := LoadCA(certPath, keyPath)
+	if err != nil {
+		t.Fatalf("LoadCA: %v", err)
+	}
+	if !loaded.Cert.Equal(ca.Cert) {
+		t.Errorf("LoadCA returned another certificate")
+	}
+	key, _ := GenerateKey(ECDSA)
+	client, err := loaded.IssueClient(key.Public(), "ops", nil, time.Hour)
+	if err != nil {
+		t.Fatalf("IssueClient: %v", err)
+	}
+	if err := client.CheckSignatureFrom(ca.Cert); err != nil {
+		t.Errorf("the loaded CA signs with another key: %v", err)
+	}
+}
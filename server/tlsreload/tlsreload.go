@@ -0,0 +1,150 @@
+// Package tlsreload serves TLS certificates and client CAs which are
+// reloaded from their files while the server runs.
+package tlsreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader holds the TLS configuration loaded from a certificate, its key
+// and a CA bundle verifying client certificates. New handshakes use the
+// configuration of the last successful load, established connections keep
+// theirs.
+type Reloader struct {
+	certPath   string
+	keyPath    string
+	caPath     string
+	clientAuth tls.ClientAuthType
+	now        func() time.Time
+
+	mu     sync.RWMutex
+	config *tls.Config
+	// stamp identifies the versions of the files seen by the last load.
+	stamp string
+}
+
+// New loads the certificate, key and CA bundle. clientAuth is the policy
+// for client certificates, e.g. tls.RequireAndVerifyClientCert.
+func New(certPath, keyPath, caPath string, clientAuth tls.ClientAuthType) (*Reloader, error) {
+	r := &Reloader{
+		certPath:   certPath,
+		keyPath:    keyPath,
+		caPath:     caPath,
+		clientAuth: clientAuth,
+		now:        time.Now,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig returns a configuration for tls.Server which hands out the
+// current configuration to every new handshake.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current(), nil
+		},
+	}
+}
+
+func (r *Reloader) current() *tls.Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.config
+}
+
+// Reload loads the files again. If they are invalid, the error is returned
+// and the previous configuration stays in use.
+func (r *Reloader) Reload() error {
+	// The files are stamped before they are read, so a change while they
+	// are read is picked up by the next ReloadIfChanged.
+	stamp := r.fileStamp()
+	config, leaf, err := r.load()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stamp = stamp
+	if err != nil {
+		return err
+	}
+	r.config = config
+	log.Printf("TLS certificate %q loaded, it expires %s", leaf.Subject, leaf.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// ReloadIfChanged reloads the files if any of them has changed since the
+// last load. It reports whether they were reloaded.
+func (r *Reloader) ReloadIfChanged() (bool, error) {
+	r.mu.RLock()
+	stamp := r.stamp
+	r.mu.RUnlock()
+	if r.fileStamp() == stamp {
+		return false, nil
+	}
+	return true, r.Reload()
+}
+
+// WatchEvery checks the files for changes every interval and reloads them.
+// Failed reloads are logged.
+func (r *Reloader) WatchEvery(interval time.Duration) {
+	for range time.Tick(interval) {
+		if _, err := r.ReloadIfChanged(); err != nil {
+			log.Printf("Reloading TLS certificates, keeping the previous ones: %s", err)
+		}
+	}
+}
+
+// fileStamp returns the modification times and sizes of the files. A file
+// which cannot be read is stamped with the error, Reload reports it.
+func (r *Reloader) fileStamp() string {
+	var stamp string
+	for _, path := range []string{r.certPath, r.keyPath, r.caPath} {
+		fi, err := os.Stat(path)
+		if err != nil {
+			stamp += fmt.Sprintf("%s: %s\n", path, err)
+			continue
+		}
+		stamp += fmt.Sprintf("%s: %d %d\n", path, fi.ModTime().UnixNano(), fi.Size())
+	}
+	return stamp
+}
+
+// load reads the files into a configuration and returns it with the leaf
+// certificate.
+func (r *Reloader) load() (*tls.Config, *x509.Certificate, error) {
+	certificate, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read RSA key pair: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse certificate: %w", err)
+	}
+	if r.now().After(leaf.NotAfter) {
+		return nil, nil, fmt.Errorf("certificate %q expired %s", leaf.Subject, leaf.NotAfter.Format(time.RFC3339))
+	}
+	certificate.Leaf = leaf
+	ca, err := os.ReadFile(r.caPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read CA certificate: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if ok := certPool.AppendCertsFromPEM(ca); !ok {
+		return nil, nil, fmt.Errorf("no CA certificates in %s", r.caPath)
+	}
+	return &tls.Config{
+		ClientAuth:   r.clientAuth,
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    certPool,
+		// The configuration replaces the one gRPC set up, which offers
+		// HTTP/2 with ALPN.
+		NextProtos: []string{"h2"},
+	}, leaf, nil
+}
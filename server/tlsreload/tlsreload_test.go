@@ -0,0 +1,198 @@
+package tlsreload
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testFiles are a CA and a server certificate in a temporary directory.
+type testFiles struct {
+	t             *testing.T
+	cert, key, ca string
+	caCert        *x509.Certificate
+	caKey         *ecdsa.PrivateKey
+	serial        int64
+	modTime       time.Time
+}
+
+func newTestFiles(t *testing.T) *testFiles {
+	t.Helper()
+	dir := t.TempDir()
+	f := &testFiles{
+		t:       t,
+		cert:    filepath.Join(dir, "server.crt"),
+		key:     filepath.Join(dir, "server.key"),
+		ca:      filepath.Join(dir, "ca.crt"),
+		modTime: time.Now(),
+	}
+	f.caKey, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &f.caKey.PublicKey, f.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.caCert, _ = x509.ParseCertificate(der)
+	f.write(f.ca, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return f
+}
+
+// issue writes a server certificate signed by the CA and its key.
+func (f *testFiles) issue(cn string, notAfter time.Time) {
+	f.t.Helper()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	f.serial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1 + f.serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{cn},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, f.caCert, &key.PublicKey, f.caKey)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.write(f.cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	f.write(f.key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+// write writes a file with a later modification time than the previous one,
+// so changes are seen on file systems with coarse timestamps.
+func (f *testFiles) write(path string, b []byte) {
+	f.t.Helper()
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		f.t.Fatal(err)
+	}
+	f.modTime = f.modTime.Add(time.Second)
+	if err := os.Chtimes(path, f.modTime, f.modTime); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// served returns the common name of the certificate a new handshake gets.
+func served(t *testing.T, r *Reloader) string {
+	t.Helper()
+	config, err := r.ServerConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatalf("GetConfigForClient: %v", err)
+	}
+	return config.Certificates[0].Leaf.Subject.CommonName
+}
+
+func TestReload(t *testing.T) {
+	f := newTestFiles(t)
+	f.issue("first.example.org", time.Now().Add(time.Hour))
+	r, err := New(f.cert, f.key, f.ca, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if got := served(t, r); got != "first.example.org" {
+		t.Fatalf("serving %s, want first.example.org", got)
+	}
+	config := r.current()
+	if config.ClientAuth != tls.RequireAndVerifyClientCert || config.ClientCAs == nil {
+		t.Errorf("client certificates are not verified: %+v", config)
+	}
+
+	if reloaded, err := r.ReloadIfChanged(); reloaded || err != nil {
+		t.Fatalf("ReloadIfChanged of unchanged files = %v, %v", reloaded, err)
+	}
+	f.issue("second.example.org", time.Now().Add(time.Hour))
+	if reloaded, err := r.ReloadIfChanged(); !reloaded || err != nil {
+		t.Fatalf("ReloadIfChanged of a new certificate = %v, %v", reloaded, err)
+	}
+	if got := served(t, r); got != "second.example.org" {
+		t.Fatalf("serving %s, want second.example.org", got)
+	}
+}
+
+func TestReloadInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*testFiles)
+		wantErr string
+	}{
+		{
+			name:    "MismatchedKey",
+			change:  func(f *testFiles) { f.write(f.cert, mustRead(t, f.ca)) },
+			wantErr: "private key does not match public key",
+		},
+		{
+			name:    "Expired",
+			change:  func(f *testFiles) { f.issue("expired.example.org", time.Now().Add(-time.Hour)) },
+			wantErr: "expired",
+		},
+		{
+			name:    "EmptyCA",
+			change:  func(f *testFiles) { f.write(f.ca, []byte("not a certificate\n")) },
+			wantErr: "no CA certificates",
+		},
+		{
+			name:    "MissingKey",
+			change:  func(f *testFiles) { os.Remove(f.key) },
+			wantErr: "no such file",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newTestFiles(t)
+			f.issue("first.example.org", time.Now().Add(time.Hour))
+			r, err := New(f.cert, f.key, f.ca, tls.RequireAndVerifyClientCert)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			tc.change(f)
+			reloaded, err := r.ReloadIfChanged()
+			if !reloaded || err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("ReloadIfChanged = %v, %v; want an error containing %q", reloaded, err, tc.wantErr)
+			}
+			if got := served(t, r); got != "first.example.org" {
+				t.Fatalf("serving %s after a failed reload, want first.example.org", got)
+			}
+			// A failed load is not retried until the files change again.
+			if reloaded, _ := r.ReloadIfChanged(); reloaded {
+				t.Errorf("the unchanged invalid files were reloaded")
+			}
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	f := newTestFiles(t)
+	if _, err := New(f.cert, f.key, f.ca, tls.RequireAndVerifyClientCert); err == nil {
+		t.Fatal("New succeeded without a certificate")
+	}
+}
+
+func mustRead(t *testing.T, path string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+	"github.com/atsevan/wireguard-grpc/server/wgserver"
+)
+
+// NodeManagerServerV2 serves the v2 WireGuard API with string keys,
+// networks and endpoints. It converts requests and responses and relies on
+// NodeManagerServer for everything else, including secret handling.
+type NodeManagerServerV2 struct {
+	wgv2.UnimplementedWireGuardServer
+	v1 *NodeManagerServer
+}
+
+// ConfigureDevice configures a WireGuard device by its interface name.
+func (s *NodeManagerServerV2) ConfigureDevice(ctx context.Context, in *wgv2.ConfigureDeviceRequest) (*wgv2.ConfigureDeviceResponse, error) {
+	cfg, err := wgserver.ConfigFromV2("config", in.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.v1.ConfigureDevice(ctx, &pb.ConfigureDeviceRequest{
+		Name:             in.GetName(),
+		Config:           cfg,
+		ExpectedRevision: in.GetExpectedRevision(),
+	})
+	return &wgv2.ConfigureDeviceResponse{}, err
+}
+
+// Device retrieves a WireGuard device by its interface name.
+func (s *NodeManagerServerV2) Device(ctx context.Context, in *wgv2.DeviceRequest) (*wgv2.DeviceResponse, error) {
+	resp, err := s.v1.Device(ctx, &pb.DeviceRequest{Name: in.GetName(), IncludeSecrets: in.GetIncludeSecrets()})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.DeviceResponse{Device: wgserver.DeviceToV2(resp.GetDevice())}, nil
+}
+
+// Devices retrieves all WireGuard devices on the host.
+func (s *NodeManagerServerV2) Devices(ctx context.Context, in *wgv2.DevicesRequest) (*wgv2.DevicesResponse, error) {
+	resp, err := s.v1.Devices(ctx, &pb.DevicesRequest{IncludeSecrets: in.GetIncludeSecrets()})
+	if err != nil {
+		return nil, err
+	}
+	out := &wgv2.DevicesResponse{}
+	for _, d := range resp.GetDevices() {
+		out.Devices = append(out.Devices, wgserver.DeviceToV2(d))
+	}
+	return out, nil
+}
+
+// CreateDevice creates a WireGuard link and configures it.
+func (s *NodeManagerServerV2) CreateDevice(ctx context.Context, in *wgv2.CreateDeviceRequest) (*wgv2.CreateDeviceResponse, error) {
+	cfg, err := wgserver.ConfigFromV2("config", in.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.CreateDevice(ctx, &pb.CreateDeviceRequest{Name: in.GetName(), Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.CreateDeviceResponse{Device: wgserver.DeviceToV2(resp.GetDevice())}, nil
+}
+
+// DeleteDevice removes a WireGuard link.
+func (s *NodeManagerServerV2) DeleteDevice(ctx context.Context, in *wgv2.DeleteDeviceRequest) (*wgv2.DeleteDeviceResponse, error) {
+	_, err := s.v1.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Name: in.GetName()})
+	return &wgv2.DeleteDeviceResponse{}, err
+}
+
+// AddAddress assigns an IP address to a WireGuard link.
+func (s *NodeManagerServerV2) AddAddress(ctx context.Context, in *wgv2.AddAddressRequest) (*wgv2.AddAddressResponse, error) {
+	addr, err := wgserver.ParseIPNet("address", in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.v1.AddAddress(ctx, &pb.AddAddressRequest{Name: in.GetName(), Address: addr})
+	return &wgv2.AddAddressResponse{}, err
+}
+
+// RemoveAddress removes an IP address from a WireGuard link.
+func (s *NodeManagerServerV2) RemoveAddress(ctx context.Context, in *wgv2.RemoveAddressRequest) (*wgv2.RemoveAddressResponse, error) {
+	addr, err := wgserver.ParseIPNet("address", in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.v1.RemoveAddress(ctx, &pb.RemoveAddressRequest{Name: in.GetName(), Address: addr})
+	return &wgv2.RemoveAddressResponse{}, err
+}
+
+// AddPeer adds a peer to a WireGuard device.
+func (s *NodeManagerServerV2) AddPeer(ctx context.Context, in *wgv2.AddPeerRequest) (*wgv2.AddPeerResponse, error) {
+	peer, err := wgserver.PeerConfigFromV2("peer", in.GetPeer())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.AddPeer(ctx, &pb.AddPeerRequest{
+		Name:             in.GetName(),
+		Peer:             peer,
+		GenerateKeypair:  in.GetGenerateKeypair(),
+		ExpectedRevision: in.GetExpectedRevision(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.AddPeerResponse{
+		Peer:       wgserver.PeerToV2(resp.GetPeer()),
+		PrivateKey: wgserver.FormatKey(resp.GetPrivateKey()),
+	}, nil
+}
+
+// UpdatePeer changes an existing peer of a WireGuard device.
+func (s *NodeManagerServerV2) UpdatePeer(ctx context.Context, in *wgv2.UpdatePeerRequest) (*wgv2.UpdatePeerResponse, error) {
+	peer, err := wgserver.PeerConfigFromV2("peer", in.GetPeer())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.UpdatePeer(ctx, &pb.UpdatePeerRequest{
+		Name:             in.GetName(),
+		Peer:             peer,
+		ExpectedRevision: in.GetExpectedRevision(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.UpdatePeerResponse{Peer: wgserver.PeerToV2(resp.GetPeer())}, nil
+}
+
+// RemovePeer removes a peer from a WireGuard device.
+func (s *NodeManagerServerV2) RemovePeer(ctx context.Context, in *wgv2.RemovePeerRequest) (*wgv2.RemovePeerResponse, error) {
+	key, err := wgserver.ParseKey("public_key", in.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.v1.RemovePeer(ctx, &pb.RemovePeerRequest{
+		Name:             in.GetName(),
+		PublicKey:        key,
+		ExpectedRevision: in.GetExpectedRevision(),
+	})
+	return &wgv2.RemovePeerResponse{}, err
+}
+
+// GetPeer retrieves a single peer of a WireGuard device by its public key.
+func (s *NodeManagerServerV2) GetPeer(ctx context.Context, in *wgv2.GetPeerRequest) (*wgv2.GetPeerResponse, error) {
+	key, err := wgserver.ParseKey("public_key", in.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.GetPeer(ctx, &pb.GetPeerRequest{
+		Name:           in.GetName(),
+		PublicKey:      key,
+		IncludeSecrets: in.GetIncludeSecrets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.GetPeerResponse{Peer: wgserver.PeerToV2(resp.GetPeer())}, nil
+}
+
+// ListPeers retrieves all peers of a WireGuard device.
+func (s *NodeManagerServerV2) ListPeers(ctx context.Context, in *wgv2.ListPeersRequest) (*wgv2.ListPeersResponse, error) {
+	resp, err := s.v1.ListPeers(ctx, &pb.ListPeersRequest{Name: in.GetName(), IncludeSecrets: in.GetIncludeSecrets()})
+	if err != nil {
+		return nil, err
+	}
+	out := &wgv2.ListPeersResponse{}
+	for _, p := range resp.GetPeers() {
+		out.Peers = append(out.Peers, wgserver.PeerToV2(p))
+	}
+	return out, nil
+}
+
+// SetMTU sets the MTU of a WireGuard link.
+func (s *NodeManagerServerV2) SetMTU(ctx context.Context, in *wgv2.SetMTURequest) (*wgv2.SetMTUResponse, error) {
+	_, err := s.v1.SetMTU(ctx, &pb.SetMTURequest{Name: in.GetName(), Mtu: in.GetMtu()})
+	return &wgv2.SetMTUResponse{}, err
+}
+
+// SetLinkState brings a WireGuard device up or down.
+func (s *NodeManagerServerV2) SetLinkState(ctx context.Context, in *wgv2.SetLinkStateRequest) (*wgv2.SetLinkStateResponse, error) {
+	_, err := s.v1.SetLinkState(ctx, &pb.SetLinkStateRequest{Name: in.GetName(), Up: in.GetUp()})
+	return &wgv2.SetLinkStateResponse{}, err
+}
+
+// WatchDevice streams the changes of a WireGuard device, starting with a snapshot.
+func (s *NodeManagerServerV2) WatchDevice(in *wgv2.WatchDeviceRequest, stream wgv2.WireGuard_WatchDeviceServer) error {
+	return s.v1.wgs.WatchDevice(stream.Context(), in.GetName(), s.v1.watchInterval(in.GetInterval()), func(ev *pb.DeviceEvent) error {
+		return stream.Send(wgserver.EventToV2(redactEvent(ev)))
+	})
+}
+
+// WatchDevices streams the changes of all WireGuard devices, starting with
+// a snapshot of every device.
+func (s *NodeManagerServerV2) WatchDevices(in *wgv2.WatchDevicesRequest, stream wgv2.WireGuard_WatchDevicesServer) error {
+	return s.v1.wgs.WatchDevices(stream.Context(), s.v1.watchInterval(in.GetInterval()), func(ev *pb.DeviceEvent) error {
+		return stream.Send(wgserver.EventToV2(redactEvent(ev)))
+	})
+}
+
+// Reconcile brings every device of the desired state back to it.
+func (s *NodeManagerServerV2) Reconcile(ctx context.Context, in *wgv2.ReconcileRequest) (*wgv2.ReconcileResponse, error) {
+	resp, err := s.v1.Reconcile(ctx, &pb.ReconcileRequest{})
+	out := &wgv2.ReconcileResponse{}
+	for _, d := range resp.GetDevices() {
+		out.Devices = append(out.Devices, &wgv2.DeviceReconciliation{
+			Name:  d.GetName(),
+			Fixes: d.GetFixes(),
+			Error: d.GetError(),
+		})
+	}
+	return out, err
+}
+
+// ExportConfig renders a WireGuard device as a `wg setconf` or wg-quick file.
+func (s *NodeManagerServerV2) ExportConfig(ctx context.Context, in *wgv2.ExportConfigRequest) (*wgv2.ExportConfigResponse, error) {
+	resp, err := s.v1.ExportConfig(ctx, &pb.ExportConfigRequest{
+		Name:           in.GetName(),
+		Format:         pb.ConfigFormat(in.GetFormat()),
+		IncludeSecrets: in.GetIncludeSecrets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.ExportConfigResponse{Config: resp.GetConfig()}, nil
+}
+
+// ImportConfig replaces the configuration of a WireGuard device by a file.
+func (s *NodeManagerServerV2) ImportConfig(ctx context.Context, in *wgv2.ImportConfigRequest) (*wgv2.ImportConfigResponse, error) {
+	resp, err := s.v1.ImportConfig(ctx, &pb.ImportConfigRequest{
+		Name:             in.GetName(),
+		Config:           in.GetConfig(),
+		ExpectedRevision: in.GetExpectedRevision(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.ImportConfigResponse{Device: wgserver.DeviceToV2(resp.GetDevice())}, nil
+}
+
+// GeneratePeerConfig renders the wg-quick configuration and QR code of a client
+// connecting to an existing peer.
+func (s *NodeManagerServerV2) GeneratePeerConfig(ctx context.Context, in *wgv2.GeneratePeerConfigRequest) (*wgv2.GeneratePeerConfigResponse, error) {
+	req, err := wgserver.GeneratePeerConfigFromV2(in)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.GeneratePeerConfig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.GeneratePeerConfigResponse{Config: resp.GetConfig(), QrCodePng: resp.GetQrCodePng()}, nil
+}
+
+// GenerateKeyPair generates a private key and its public key.
+func (s *NodeManagerServerV2) GenerateKeyPair(ctx context.Context, in *wgv2.GenerateKeyPairRequest) (*wgv2.GenerateKeyPairResponse, error) {
+	resp, err := s.v1.GenerateKeyPair(ctx, &pb.GenerateKeyPairRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.GenerateKeyPairResponse{
+		PrivateKey: wgserver.FormatKey(resp.GetPrivateKey()),
+		PublicKey:  wgserver.FormatKey(resp.GetPublicKey()),
+	}, nil
+}
+
+// GeneratePresharedKey generates a preshared key.
+func (s *NodeManagerServerV2) GeneratePresharedKey(ctx context.Context, in *wgv2.GeneratePresharedKeyRequest) (*wgv2.GeneratePresharedKeyResponse, error) {
+	resp, err := s.v1.GeneratePresharedKey(ctx, &pb.GeneratePresharedKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.GeneratePresharedKeyResponse{PresharedKey: wgserver.FormatKey(resp.GetPresharedKey())}, nil
+}
+
+// DerivePublicKey returns the public key of a private key.
+func (s *NodeManagerServerV2) DerivePublicKey(ctx context.Context, in *wgv2.DerivePublicKeyRequest) (*wgv2.DerivePublicKeyResponse, error) {
+	key, err := wgserver.ParseKey("private_key", in.GetPrivateKey())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.DerivePublicKey(ctx, &pb.DerivePublicKeyRequest{PrivateKey: key})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.DerivePublicKeyResponse{PublicKey: wgserver.FormatKey(resp.GetPublicKey())}, nil
+}
+
+// SetAddressPools replaces the networks peer addresses of a WireGuard device
+// are allocated from.
+func (s *NodeManagerServerV2) SetAddressPools(ctx context.Context, in *wgv2.SetAddressPoolsRequest) (*wgv2.SetAddressPoolsResponse, error) {
+	prefixes, err := wgserver.ParseIPNets("prefixes", in.GetPrefixes())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.v1.SetAddressPools(ctx, &pb.SetAddressPoolsRequest{Name: in.GetName(), Prefixes: prefixes})
+	return &wgv2.SetAddressPoolsResponse{}, err
+}
+
+// GetAddressPools returns the address pools and leases of a WireGuard device.
+func (s *NodeManagerServerV2) GetAddressPools(ctx context.Context, in *wgv2.GetAddressPoolsRequest) (*wgv2.GetAddressPoolsResponse, error) {
+	resp, err := s.v1.GetAddressPools(ctx, &pb.GetAddressPoolsRequest{Name: in.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.GetAddressPoolsResponse{Pools: wgserver.AddressPoolsToV2(resp.GetPools())}, nil
+}
+
+// AllocatePeer adds a peer with the next free addresses of the pools of a
+// WireGuard device.
+func (s *NodeManagerServerV2) AllocatePeer(ctx context.Context, in *wgv2.AllocatePeerRequest) (*wgv2.AllocatePeerResponse, error) {
+	peer, err := wgserver.PeerConfigFromV2("peer", in.GetPeer())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.AllocatePeer(ctx, &pb.AllocatePeerRequest{
+		Name:            in.GetName(),
+		Peer:            peer,
+		GenerateKeypair: in.GetGenerateKeypair(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wgv2.AllocatePeerResponse{
+		Peer:       wgserver.PeerToV2(resp.GetPeer()),
+		Addresses:  wgserver.FormatIPNets(resp.GetAddresses()),
+		PrivateKey: wgserver.FormatKey(resp.GetPrivateKey()),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchError reports the failed step of BatchConfigure.
+//
+// It wraps the error of the step, so errors.Is and StatusError see it.
+type BatchError struct {
+	// Step is the index of the failed step.
+	Step int
+	// Name is the device of the failed step.
+	Name string
+	// Err is why the step failed.
+	Err error
+	// RollbackErr is nil if every changed device was restored.
+	RollbackErr error
+}
+
+func (e *BatchError) Error() string {
+	msg := fmt.Sprintf("step %d (%s): %s", e.Step, e.Name, e.Err)
+	if e.RollbackErr != nil {
+		return msg + "; rollback failed: " + e.RollbackErr.Error()
+	}
+	return msg + "; rolled back"
+}
+
+// Unwrap returns the error of the failed step.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the status of the failed step carrying a BatchFailure
+// detail.
+func (e *BatchError) GRPCStatus() *status.Status {
+	st, _ := status.FromError(StatusError(e.Err))
+	st = status.New(st.Code(), e.Error())
+	failure := &pb.BatchFailure{
+		Step:       int32(e.Step),
+		Name:       e.Name,
+		Error:      e.Err.Error(),
+		RolledBack: e.RollbackErr == nil,
+	}
+	if e.RollbackErr != nil {
+		failure.RollbackError = e.RollbackErr.Error()
+	}
+	if withDetails, err := st.WithDetails(failure); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// BatchConfigure applies several device configurations in order. Every
+// device is snapshotted before the first step. If a step fails, the devices
+// changed so far, including the one of the failed step, are restored to
+// their snapshot in reverse order and a BatchError is returned.
+//
+// The steps are validated up front, an invalid step changes nothing. The
+// expected revisions of the steps are checked against the devices before
+// the first step. Every device of the batch is locked until the batch is
+// applied or rolled back.
+func (wgs *WGServer) BatchConfigure(steps []*pb.ConfigureDeviceRequest) error {
+	verr := &ValidationError{}
+	for i, step := range steps {
+		path := fmt.Sprintf("steps[%d]", i)
+		if step.GetName() == "" {
+			verr.add(path+".name", "must not be empty")
+		}
+		validateConfig(verr, path+".config", step.GetConfig())
+	}
+	if err := verr.err(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(steps))
+	for _, step := range steps {
+		names = append(names, step.GetName())
+	}
+	defer wgs.lockDevices(names...)()
+
+	devices := map[string]*wgtypes.Device{}
+	snapshots := map[string]wgtypes.Config{}
+	for _, step := range steps {
+		name := step.GetName()
+		dev, ok := devices[name]
+		if !ok {
+			var err error
+			if dev, err = wgs.c.Device(name); err != nil {
+				return err
+			}
+			devices[name] = dev
+			snapshots[name] = snapshotConfig(dev)
+		}
+		if err := checkRevision(dev, step.GetExpectedRevision()); err != nil {
+			return err
+		}
+	}
+
+	var changed []string
+	for i, step := range steps {
+		name := step.GetName()
+		if !contains(changed, name) {
+			changed = append(changed, name)
+		}
+		if err := wgs.c.ConfigureDevice(name, pbConfig2wgConfig(step.GetConfig())); err != nil {
+			return &BatchError{Step: i, Name: name, Err: err, RollbackErr: wgs.restore(changed, snapshots)}
+		}
+	}
+	var errs []error
+	for _, name := range changed {
+		errs = append(errs, wgs.record(name), wgs.releaseRemovedPeers(name))
+	}
+	return errors.Join(errs...)
+}
+
+// restore configures the devices with their snapshot, last changed first.
+func (wgs *WGServer) restore(names []string, snapshots map[string]wgtypes.Config) error {
+	var errs []error
+	for i := len(names) - 1; i >= 0; i-- {
+		if err := wgs.c.ConfigureDevice(names[i], snapshots[names[i]]); err != nil {
+			errs = append(errs, fmt.Errorf("restore %s: %w", names[i], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// snapshotConfig returns the configuration which puts a device back into
+// its current state, including its endpoints.
+func snapshotConfig(dev *wgtypes.Device) wgtypes.Config {
+	cfg := pbConfig2wgConfig(desiredConfig(dev))
+	if cfg.PrivateKey == nil {
+		// A zero key clears a private key set by a step.
+		cfg.PrivateKey = &wgtypes.Key{}
+	}
+	return cfg
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package wgserver
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBatchConfigure(t *testing.T) {
+	peer, _ := wgtypes.GenerateKey()
+	other, _ := wgtypes.GenerateKey()
+	allowed := []net.IPNet{{IP: net.IP{10, 7, 0, 2}, Mask: net.CIDRMask(32, 32)}}
+	newDevices := func() (*wgtypes.Device, *wgtypes.Device) {
+		return &wgtypes.Device{
+			Name:       "wg0",
+			ListenPort: 51820,
+			Peers:      []wgtypes.Peer{{PublicKey: peer, AllowedIPs: allowed, ProtocolVersion: 1}},
+		}, &wgtypes.Device{
+			Name:       "wg1",
+			ListenPort: 51821,
+			Peers:      []wgtypes.Peer{{PublicKey: other, ProtocolVersion: 1}},
+		}
+	}
+	// Moves the peer from wg0 to wg1 and changes the port of wg0.
+	steps := []*pb.ConfigureDeviceRequest{
+		{Name: "wg0", Config: &pb.Config{Peers: []*pb.PeerConfig{{PublicKey: peer[:], Remove: true}}}},
+		{Name: "wg1", Config: &pb.Config{Peers: []*pb.PeerConfig{{
+			PublicKey:  peer[:],
+			AllowedIps: []*pb.IPNet{{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}},
+		}}}},
+		{Name: "wg0", Config: &pb.Config{ListenPort: proto.Int32(51822)}},
+	}
+
+	tests := []struct {
+		name string
+		// fail makes the n-th call of ConfigureDevice fail, counting from 1.
+		fail    func(n int) bool
+		failure *pb.BatchFailure
+	}{
+		{
+			name: "OK",
+			fail: func(n int) bool { return false },
+		},
+		{
+			name:    "RolledBack",
+			fail:    func(n int) bool { return n == 3 },
+			failure: &pb.BatchFailure{Step: 2, Name: "wg0", Error: "configure failed", RolledBack: true},
+		},
+		{
+			name: "RollbackFailed",
+			fail: func(n int) bool { return n >= 2 },
+			failure: &pb.BatchFailure{
+				Step:          1,
+				Name:          "wg1",
+				Error:         "configure failed",
+				RollbackError: "restore wg1: configure failed\nrestore wg0: configure failed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wg0, wg1 := newDevices()
+			kernel := newTestKernel(wg0, wg1)
+			calls := 0
+			configure := kernel.ConfigureDeviceFunc
+			kernel.ConfigureDeviceFunc = func(name string, cfg wgtypes.Config) error {
+				calls++
+				if tt.fail(calls) {
+					return errors.New("configure failed")
+				}
+				return configure(name, cfg)
+			}
+			wgs := WGServer{c: kernel}
+
+			err := wgs.BatchConfigure(steps)
+			if tt.failure == nil {
+				if err != nil {
+					t.Fatalf("BatchConfigure: %v", err)
+				}
+				if len(wg0.Peers) != 0 || wg0.ListenPort != 51822 || len(wg1.Peers) != 2 {
+					t.Fatalf("batch not applied: %+v %+v", wg0, wg1)
+				}
+				return
+			}
+			st := status.Convert(err)
+			if diff := cmp.Diff(codes.Internal, st.Code()); diff != "" {
+				t.Fatalf("unexpected code (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]interface{}{tt.failure}, st.Details(), protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected details (-want +got):\n%s", diff)
+			}
+			if tt.failure.RolledBack {
+				want0, want1 := newDevices()
+				if diff := cmp.Diff(want0, wg0); diff != "" {
+					t.Fatalf("wg0 not restored (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff(want1, wg1); diff != "" {
+					t.Fatalf("wg1 not restored (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchConfigureValidation(t *testing.T) {
+	wgs := WGServer{c: &testClient{
+		DeviceFunc: func(name string) (*wgtypes.Device, error) { return nil, os.ErrNotExist },
+	}}
+	err := wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{
+		{Name: "wg0"},
+		{Config: &pb.Config{Peers: []*pb.PeerConfig{{}}}},
+	})
+	want := &ValidationError{Violations: []FieldViolation{
+		{Field: "steps[1].name", Description: "must not be empty"},
+		{Field: "steps[1].config.peers[0].public_key", Description: "is required"},
+	}}
+	if diff := cmp.Diff(error(want), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+
+	err = wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{{Name: "wg0"}})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("unexpected error for a missing device: %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+package wgserver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	qrcode "github.com/skip2/go-qrcode"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// qrCodeSize is the width and height of generated QR codes in pixels.
+const qrCodeSize = 512
+
+// fullTunnel are the allowed IPs routing all traffic through the tunnel.
+var fullTunnel = []string{"0.0.0.0/0", "::/0"}
+
+// GeneratePeerConfig renders the wg-quick configuration of a client which
+// connects to an existing peer of a device, and the configuration as a PNG
+// QR code.
+//
+// The preshared key of the peer is only written if includeSecrets is set;
+// without it a peer with a preshared key is a FailedPrecondition, as the
+// configuration could never complete a handshake. An error matching
+// os.ErrNotExist is returned if the device or the peer does not exist.
+func (wgs *WGServer) GeneratePeerConfig(req *pb.GeneratePeerConfigRequest, includeSecrets bool) (string, []byte, error) {
+	if err := validatePeerConfigRequest(req); err != nil {
+		return "", nil, err
+	}
+	dev, err := wgs.Device(req.GetName())
+	if err != nil {
+		return "", nil, err
+	}
+	var peer *pb.Peer
+	for _, p := range dev.GetPeers() {
+		if string(p.GetPublicKey()) == string(req.GetPublicKey()) {
+			peer = p
+		}
+	}
+	if peer == nil {
+		return "", nil, fmt.Errorf("peer %s on %s: %w", base64.StdEncoding.EncodeToString(req.GetPublicKey()), req.GetName(), os.ErrNotExist)
+	}
+	if !includeSecrets {
+		RedactPeerSecrets(peer)
+	}
+	if peer.GetHasPresharedKey() && !isSetKey(peer.GetPresharedKey()) {
+		return "", nil, status.Error(codes.FailedPrecondition, "the peer has a preshared key, set include_secrets to write it into the configuration")
+	}
+
+	addresses := req.GetAddresses()
+	if len(addresses) == 0 {
+		addresses = peer.GetAllowedIps()
+	}
+	if len(addresses) == 0 {
+		return "", nil, invalidField("addresses", "are required, the peer has no allowed ips")
+	}
+	allowedIPs := fullTunnel
+	if req.GetTunnelMode() == pb.TunnelMode_SPLIT_TUNNEL {
+		allowedIPs = splitTunnel(req.GetAllowedIps(), dev.GetAddresses())
+		if len(allowedIPs) == 0 {
+			return "", nil, invalidField("allowed_ips", "are required for SPLIT_TUNNEL, the device has no addresses")
+		}
+	}
+	port := req.GetEndpointPort()
+	if port == 0 {
+		port = dev.GetListenPort()
+	}
+
+	var b strings.Builder
+	b.WriteString("[Interface]\n")
+	fmt.Fprintf(&b, "PrivateKey = %s\n", base64.StdEncoding.EncodeToString(req.GetPrivateKey()))
+	fmt.Fprintf(&b, "Address = %s\n", joinIPNets(addresses))
+	if len(req.GetDns()) > 0 {
+		fmt.Fprintf(&b, "DNS = %s\n", strings.Join(req.GetDns(), ", "))
+	}
+	if req.GetMtu() > 0 {
+		fmt.Fprintf(&b, "MTU = %d\n", req.GetMtu())
+	}
+	b.WriteString("\n[Peer]\n")
+	fmt.Fprintf(&b, "PublicKey = %s\n", base64.StdEncoding.EncodeToString(dev.GetPublicKey()))
+	if peer.GetHasPresharedKey() {
+		fmt.Fprintf(&b, "PresharedKey = %s\n", base64.StdEncoding.EncodeToString(peer.GetPresharedKey()))
+	}
+	fmt.Fprintf(&b, "AllowedIPs = %s\n", strings.Join(allowedIPs, ", "))
+	fmt.Fprintf(&b, "Endpoint = %s\n", net.JoinHostPort(req.GetEndpointHost(), strconv.Itoa(int(port))))
+	if ka := req.GetPersistentKeepaliveInterval().AsDuration(); ka > 0 {
+		fmt.Fprintf(&b, "PersistentKeepalive = %d\n", int(ka.Seconds()))
+	}
+	config := b.String()
+
+	png, err := qrcode.Encode(config, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding QR code: %w", err)
+	}
+	return config, png, nil
+}
+
+// validatePeerConfigRequest checks a GeneratePeerConfig request.
+func validatePeerConfigRequest(req *pb.GeneratePeerConfigRequest) error {
+	verr := &ValidationError{}
+	if req.GetName() == "" {
+		verr.add("name", "must not be empty")
+	}
+	validateKey(verr, "public_key", req.GetPublicKey(), true)
+	validateKey(verr, "private_key", req.GetPrivateKey(), true)
+	if len(req.GetPublicKey()) == wgtypes.KeyLen && len(req.GetPrivateKey()) == wgtypes.KeyLen {
+		if wgtypes.Key(req.GetPrivateKey()).PublicKey() != wgtypes.Key(req.GetPublicKey()) {
+			verr.add("private_key", "does not belong to public_key")
+		}
+	}
+	validateHost(verr, "endpoint_host", req.GetEndpointHost())
+	validatePort(verr, "endpoint_port", req.GetEndpointPort(), 0)
+	for i, a := range req.GetAddresses() {
+		validateAddress(verr, fmt.Sprintf("addresses[%d]", i), pb2IPNet(a))
+	}
+	for i, dns := range req.GetDns() {
+		// Servers are addresses, search domains DNS names.
+		validateHost(verr, fmt.Sprintf("dns[%d]", i), dns)
+	}
+	for i, ipn := range req.GetAllowedIps() {
+		validateIPNet(verr, fmt.Sprintf("allowed_ips[%d]", i), ipn)
+	}
+	if req.GetMtu() != 0 {
+		validateMTU(verr, "mtu", req.GetMtu())
+	}
+	if req.PersistentKeepaliveInterval != nil {
+		validateKeepalive(verr, "persistent_keepalive_interval", req.PersistentKeepaliveInterval)
+	}
+	return verr.err()
+}
+
+// validateHost checks that host is an IP address or a DNS name, so it can't
+// add lines or entries to the configuration.
+func validateHost(verr *ValidationError, field, host string) {
+	if host == "" {
+		verr.add(field, "must not be empty")
+		return
+	}
+	if net.ParseIP(host) != nil {
+		return
+	}
+	if len(host) > 253 {
+		verr.add(field, "must be an IP address or a DNS name, got %d characters", len(host))
+		return
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(host, "."), ".") {
+		if !isDNSLabel(label) {
+			verr.add(field, "must be an IP address or a DNS name, got %q", host)
+			return
+		}
+	}
+}
+
+// isDNSLabel reports whether label is a label of a host name: letters,
+// digits and inner hyphens, at most 63 of them.
+func isDNSLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// splitTunnel returns the allowed IPs of a split tunnel: allowedIPs if set,
+// otherwise the networks of the device addresses.
+func splitTunnel(allowedIPs, addresses []*pb.IPNet) []string {
+	if len(allowedIPs) > 0 {
+		addresses = allowedIPs
+	}
+	var networks []string
+	seen := map[string]bool{}
+	for _, a := range addresses {
+		ipn := pb2IPNet(a)
+		network := (&net.IPNet{IP: ipn.IP.Mask(ipn.Mask), Mask: ipn.Mask}).String()
+		if !seen[network] {
+			seen[network] = true
+			networks = append(networks, network)
+		}
+	}
+	return networks
+}
+
+func joinIPNets(ipns []*pb.IPNet) string {
+	s := make([]string, 0, len(ipns))
+	for _, ipn := range ipns {
+		s = append(s, pb2IPNet(ipn).String())
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,141 @@
+package wgserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestGeneratePeerConfig(t *testing.T) {
+	serverKey, _ := wgtypes.ParseKey(testPrivateKey)
+	clientKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.ParseKey(testPSK)
+	wgs := WGServer{
+		c: newTestKernel(&wgtypes.Device{
+			Name:       "wg0",
+			PrivateKey: serverKey,
+			PublicKey:  serverKey.PublicKey(),
+			ListenPort: 51820,
+			Peers: []wgtypes.Peer{{
+				PublicKey:    clientKey.PublicKey(),
+				PresharedKey: psk,
+				AllowedIPs:   []net.IPNet{{IP: net.IP{10, 7, 0, 2}, Mask: net.CIDRMask(32, 32)}},
+			}},
+		}),
+		l: &testLinkManager{LinkFunc: func(name string) (*Link, error) {
+			return &Link{MTU: 1420, Addresses: []net.IPNet{{IP: net.IP{10, 7, 0, 1}, Mask: net.CIDRMask(24, 32)}}}, nil
+		}},
+	}
+	pub := clientKey.PublicKey()
+	req := func() *pb.GeneratePeerConfigRequest {
+		return &pb.GeneratePeerConfigRequest{
+			Name:         "wg0",
+			PublicKey:    pub[:],
+			PrivateKey:   clientKey[:],
+			EndpointHost: "vpn.example.com",
+		}
+	}
+
+	// Without the preshared key the client could never complete a handshake.
+	if _, _, err := wgs.GeneratePeerConfig(req(), false); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("GeneratePeerConfig without secrets of a peer with a preshared key: want FailedPrecondition, got %v", err)
+	}
+	config, png, err := wgs.GeneratePeerConfig(req(), true)
+	if err != nil {
+		t.Fatalf("GeneratePeerConfig: %v", err)
+	}
+	want := `[Interface]
+PrivateKey = ` + clientKey.String() + `
+Address = 10.7.0.2/32
+
+[Peer]
+PublicKey = ` + serverKey.PublicKey().String() + `
+PresharedKey = ` + testPSK + `
+AllowedIPs = 0.0.0.0/0, ::/0
+Endpoint = vpn.example.com:51820
+`
+	if diff := cmp.Diff(want, config); diff != "" {
+		t.Fatalf("unexpected full tunnel config (-want +got):\n%s", diff)
+	}
+	if !bytes.HasPrefix(png, []byte("\x89PNG")) {
+		t.Fatalf("QR code is not a PNG: %q", png[:8])
+	}
+
+	split := req()
+	split.TunnelMode = pb.TunnelMode_SPLIT_TUNNEL
+	split.EndpointHost = "2001:db8::1"
+	split.Dns = []string{"10.7.0.1", "example.com"}
+	split.PersistentKeepaliveInterval = durationpb.New(25 * time.Second)
+	config, _, err = wgs.GeneratePeerConfig(split, true)
+	if err != nil {
+		t.Fatalf("GeneratePeerConfig: %v", err)
+	}
+	want = `[Interface]
+PrivateKey = ` + clientKey.String() + `
+Address = 10.7.0.2/32
+DNS = 10.7.0.1, example.com
+
+[Peer]
+PublicKey = ` + serverKey.PublicKey().String() + `
+PresharedKey = ` + testPSK + `
+AllowedIPs = 10.7.0.0/24
+Endpoint = [2001:db8::1]:51820
+PersistentKeepalive = 25
+`
+	if diff := cmp.Diff(want, config); diff != "" {
+		t.Fatalf("unexpected split tunnel config (-want +got):\n%s", diff)
+	}
+
+	other, _ := wgtypes.GeneratePrivateKey()
+	invalid := req()
+	invalid.PrivateKey = other[:]
+	invalid.EndpointHost = ""
+	_, _, err = wgs.GeneratePeerConfig(invalid, false)
+	wantErr := &ValidationError{Violations: []FieldViolation{
+		{Field: "private_key", Description: "does not belong to public_key"},
+		{Field: "endpoint_host", Description: "must not be empty"},
+	}}
+	if diff := cmp.Diff(error(wantErr), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+	for _, host := range []string{"vpn.example.com\n[Peer]", "vpn example.com", "vpn.example.com,10.0.0.1", "-vpn.example.com", "vpn..example.com"} {
+		injected := req()
+		injected.EndpointHost = host
+		_, _, err := wgs.GeneratePeerConfig(injected, true)
+		want := invalidField("endpoint_host", fmt.Sprintf("must be an IP address or a DNS name, got %q", host))
+		if diff := cmp.Diff(want, err, cmpErrors); diff != "" {
+			t.Errorf("unexpected error of endpoint_host %q (-want +got):\n%s", host, diff)
+		}
+	}
+	for _, dns := range []string{"", "10.7.0.1\r\n[Peer]", "example.com\rPostUp = id", "example.com\x00", "10.7.0.1, 10.7.0.2", "example.com\x7f"} {
+		injected := req()
+		injected.Dns = []string{"10.7.0.1", dns}
+		_, _, err := wgs.GeneratePeerConfig(injected, true)
+		want := invalidField("dns[1]", fmt.Sprintf("must be an IP address or a DNS name, got %q", dns))
+		if dns == "" {
+			want = invalidField("dns[1]", "must not be empty")
+		}
+		if diff := cmp.Diff(want, err, cmpErrors); diff != "" {
+			t.Errorf("unexpected error of dns %q (-want +got):\n%s", dns, diff)
+		}
+	}
+
+	missing := req()
+	otherPub := other.PublicKey()
+	missing.PublicKey, missing.PrivateKey = otherPub[:], other[:]
+	if _, _, err := wgs.GeneratePeerConfig(missing, false); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("GeneratePeerConfig of a missing peer: want os.ErrNotExist, got %v", err)
+	}
+}
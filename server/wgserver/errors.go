@@ -0,0 +1,117 @@
+package wgserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldViolation describes a single invalid field of a request.
+type FieldViolation struct {
+	// Field is a path to the offending field, e.g. `config.peers[1].public_key`.
+	Field string
+	// Description explains why the field is invalid.
+	Description string
+}
+
+// ValidationError lists every problem found in a request.
+//
+// It can be checked using `errors.Is(err, os.ErrInvalid)`.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v.Field, v.Description))
+	}
+	return fmt.Sprintf("%s: %s", os.ErrInvalid, strings.Join(msgs, "; "))
+}
+
+// Is reports whether the target is os.ErrInvalid.
+func (e *ValidationError) Is(target error) bool {
+	return target == os.ErrInvalid
+}
+
+// GRPCStatus returns an InvalidArgument status carrying the violations as
+// google.rpc.BadRequest details.
+func (e *ValidationError) GRPCStatus() *status.Status {
+	br := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st := status.New(codes.InvalidArgument, e.Error())
+	if withDetails, err := st.WithDetails(br); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// add records a violation of the field.
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Violations = append(e.Violations, FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// err returns e if any violation has been recorded, nil otherwise.
+func (e *ValidationError) err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+// invalidField returns a ValidationError with a single violation.
+func invalidField(field, description string) error {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+// StatusError converts an error returned by WGServer into a gRPC status error.
+//
+//   - ValidationError and os.ErrInvalid become InvalidArgument,
+//   - os.ErrNotExist becomes NotFound,
+//   - os.ErrExist becomes AlreadyExists,
+//   - os.ErrPermission (EPERM, EACCES from netlink) becomes PermissionDenied,
+//   - ErrUnsupported becomes Unimplemented,
+//   - context errors become Canceled and DeadlineExceeded,
+//   - errors that already carry a status are returned unchanged,
+//   - everything else becomes Internal.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, os.ErrInvalid):
+		code = codes.InvalidArgument
+	case errors.Is(err, os.ErrNotExist):
+		code = codes.NotFound
+	case errors.Is(err, os.ErrExist):
+		code = codes.AlreadyExists
+	case errors.Is(err, os.ErrPermission):
+		code = codes.PermissionDenied
+	case errors.Is(err, ErrUnsupported):
+		code = codes.Unimplemented
+	}
+	return status.Error(code, err.Error())
+}
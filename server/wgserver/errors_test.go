@@ -0,0 +1,107 @@
+package wgserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			code: codes.OK,
+		},
+		{
+			name: "not exist",
+			err:  os.ErrNotExist,
+			code: codes.NotFound,
+		},
+		{
+			name: "wrapped not exist",
+			err:  fmt.Errorf("device wg0: %w", os.ErrNotExist),
+			code: codes.NotFound,
+		},
+		{
+			name: "exist",
+			err:  os.ErrExist,
+			code: codes.AlreadyExists,
+		},
+		{
+			name: "invalid",
+			err:  os.ErrInvalid,
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "validation",
+			err:  invalidField("name", "must not be empty"),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "netlink EPERM",
+			err:  os.NewSyscallError("sendmsg", syscall.EPERM),
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "deadline",
+			err:  context.DeadlineExceeded,
+			code: codes.DeadlineExceeded,
+		},
+		{
+			name: "status is kept",
+			err:  status.Error(codes.Unavailable, "unavailable"),
+			code: codes.Unavailable,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			code: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := status.Code(StatusError(tt.err))
+			if diff := cmp.Diff(tt.code, got); diff != "" {
+				t.Fatalf("unexpected code (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidationErrorDetails(t *testing.T) {
+	verr := &ValidationError{}
+	verr.add("name", "must not be empty")
+	verr.add("config.peers[0].public_key", "must be %d bytes, got %d", 32, 3)
+
+	if !errors.Is(verr, os.ErrInvalid) {
+		t.Fatalf("ValidationError does not match os.ErrInvalid")
+	}
+	st := status.Convert(StatusError(fmt.Errorf("configure: %w", verr)))
+	if diff := cmp.Diff(codes.InvalidArgument, st.Code()); diff != "" {
+		t.Fatalf("unexpected code (-want +got):\n%s", diff)
+	}
+	want := []interface{}{&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "name", Description: "must not be empty"},
+			{Field: "config.peers[0].public_key", Description: "must be 32 bytes, got 3"},
+		},
+	}}
+	if diff := cmp.Diff(want, st.Details(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,361 @@
+package wgserver
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var errPoolsNotPersisted = status.Error(codes.FailedPrecondition, "address pools are not persisted, the server needs a state file")
+
+// ipam keeps the address pools and leases of the devices. It is loaded from
+// the store on first use and saved to it after every change.
+type ipam struct {
+	mu     sync.Mutex
+	loaded bool
+	pools  map[string]*pb.AddressPools
+}
+
+// SetAddressPools replaces the networks peer addresses of a WireGuard device
+// are allocated from. Existing leases are kept. Pools need a store, leases
+// lost on a restart would be handed out twice.
+func (wgs *WGServer) SetAddressPools(name string, prefixes []*pb.IPNet) error {
+	if wgs.s == nil {
+		return errPoolsNotPersisted
+	}
+	verr := &ValidationError{}
+	if name == "" {
+		verr.add("name", "must not be empty")
+	}
+	for i, p := range prefixes {
+		path := fmt.Sprintf("prefixes[%d]", i)
+		validateIPNet(verr, path, p)
+		for j := 0; j < i; j++ {
+			if overlaps(pb2IPNet(prefixes[j]), pb2IPNet(p)) {
+				verr.add(path, "overlaps prefixes[%d]", j)
+			}
+		}
+	}
+	if err := verr.err(); err != nil {
+		return err
+	}
+	if _, err := wgs.c.Device(name); err != nil {
+		return err
+	}
+
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	pools, err := wgs.devicePools(name)
+	if err != nil {
+		return err
+	}
+	pools.Prefixes = prefixes
+	return wgs.savePools(pools)
+}
+
+// AddressPools returns the address pools and leases of a WireGuard device.
+func (wgs *WGServer) AddressPools(name string) (*pb.AddressPools, error) {
+	if name == "" {
+		return nil, invalidField("name", "must not be empty")
+	}
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	pools, err := wgs.devicePools(name)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(pools).(*pb.AddressPools), nil
+}
+
+// AllocatePeer adds a peer with the next free address of every address
+// family with a pool, and leases the addresses to the peer. An address is
+// free if it is not leased, not routed to an existing peer and not assigned
+// to the device.
+//
+// If generateKeypair is set, the keys of the peer are generated and its
+// private key is returned.
+func (wgs *WGServer) AllocatePeer(name string, peer *pb.PeerConfig, generateKeypair bool) (*pb.Peer, []*pb.IPNet, []byte, error) {
+	if name == "" {
+		return nil, nil, nil, invalidField("name", "must not be empty")
+	}
+	if wgs.s == nil {
+		return nil, nil, nil, errPoolsNotPersisted
+	}
+	defer wgs.lockDevices(name)()
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	pools, err := wgs.devicePools(name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(pools.GetPrefixes()) == 0 {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "device %s has no address pools", name)
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Leases of peers removed behind our back are free again.
+	pruneLeases(dev, pools)
+
+	used := wgs.usedAddresses(dev, pools)
+	var addrs []*pb.IPNet
+	for _, family := range []int{net.IPv4len, net.IPv6len} {
+		var ip net.IP
+		hasPool := false
+		for _, prefix := range pools.GetPrefixes() {
+			if len(prefix.GetIp()) != family {
+				continue
+			}
+			hasPool = true
+			if ip = nextFree(pb2IPNet(prefix), used); ip != nil {
+				break
+			}
+		}
+		if !hasPool {
+			continue
+		}
+		if ip == nil {
+			return nil, nil, nil, status.Errorf(codes.ResourceExhausted, "no free IPv%d address in the pools of %s", map[int]int{net.IPv4len: 4, net.IPv6len: 6}[family], name)
+		}
+		addrs = append(addrs, &pb.IPNet{Ip: ip, IpMask: net.CIDRMask(family*8, family*8)})
+	}
+
+	pc := &pb.PeerConfig{}
+	if peer != nil {
+		pc = proto.Clone(peer).(*pb.PeerConfig)
+	}
+	var privateKey []byte
+	if generateKeypair {
+		if pc, privateKey, err = generatedPeer(pc); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	pc.AllowedIps = append(pc.AllowedIps, addrs...)
+	wpc, err := peerRequest(name, pc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p, err := wgs.addPeer(name, wpc, "")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, a := range addrs {
+		pools.Leases = append(pools.Leases, &pb.Lease{Address: a, PublicKey: p.GetPublicKey()})
+	}
+	if err := wgs.savePools(pools); err != nil {
+		return nil, nil, nil, err
+	}
+	return p, addrs, privateKey, nil
+}
+
+// releaseLeases frees the addresses leased to a peer.
+func (wgs *WGServer) releaseLeases(name string, key wgtypes.Key) error {
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	pools, err := wgs.devicePools(name)
+	if err != nil {
+		return err
+	}
+	leases := pools.GetLeases()[:0]
+	for _, l := range pools.GetLeases() {
+		if !bytes.Equal(l.GetPublicKey(), key[:]) {
+			leases = append(leases, l)
+		}
+	}
+	if len(leases) == len(pools.GetLeases()) {
+		return nil
+	}
+	pools.Leases = leases
+	return wgs.savePools(pools)
+}
+
+// releaseRemovedPeers frees the addresses leased to peers which are no
+// longer configured on a device. It is called after changes which may have
+// removed peers.
+func (wgs *WGServer) releaseRemovedPeers(name string) error {
+	if wgs.s == nil {
+		return nil
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return fmt.Errorf("release leases of %s: %w", name, err)
+	}
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	pools, err := wgs.devicePools(name)
+	if err != nil {
+		return err
+	}
+	if !pruneLeases(dev, pools) {
+		return nil
+	}
+	return wgs.savePools(pools)
+}
+
+// dropPools removes the address pools and leases of a deleted device.
+func (wgs *WGServer) dropPools(name string) error {
+	if wgs.s == nil {
+		return nil
+	}
+	wgs.ipam.mu.Lock()
+	defer wgs.ipam.mu.Unlock()
+	if _, err := wgs.devicePools(name); err != nil {
+		return err
+	}
+	delete(wgs.ipam.pools, name)
+	return wgs.savePools(&pb.AddressPools{Name: name})
+}
+
+// pruneLeases drops the leases of peers which are not configured on dev and
+// reports whether any lease was dropped.
+func pruneLeases(dev *wgtypes.Device, pools *pb.AddressPools) bool {
+	leases := pools.GetLeases()[:0]
+	for _, l := range pools.GetLeases() {
+		if len(l.GetPublicKey()) == wgtypes.KeyLen && findPeer(dev, wgtypes.Key(l.GetPublicKey())) != nil {
+			leases = append(leases, l)
+		}
+	}
+	pruned := len(leases) != len(pools.GetLeases())
+	pools.Leases = leases
+	return pruned
+}
+
+// devicePools returns the pools of a device, loading them from the store
+// on first use. The ipam lock must be held.
+func (wgs *WGServer) devicePools(name string) (*pb.AddressPools, error) {
+	if !wgs.ipam.loaded {
+		wgs.ipam.pools = map[string]*pb.AddressPools{}
+		if wgs.s != nil {
+			pools, err := wgs.s.LoadPools()
+			if err != nil {
+				return nil, fmt.Errorf("load address pools: %w", err)
+			}
+			for _, p := range pools {
+				wgs.ipam.pools[p.GetName()] = p
+			}
+		}
+		wgs.ipam.loaded = true
+	}
+	p, ok := wgs.ipam.pools[name]
+	if !ok {
+		p = &pb.AddressPools{Name: name}
+		wgs.ipam.pools[name] = p
+	}
+	return p, nil
+}
+
+// savePools persists the pools of a device. The ipam lock must be held.
+func (wgs *WGServer) savePools(pools *pb.AddressPools) error {
+	if wgs.s == nil {
+		return nil
+	}
+	if err := wgs.s.SavePools(pools); err != nil {
+		return fmt.Errorf("save address pools of %s: %w", pools.GetName(), err)
+	}
+	return nil
+}
+
+// usedAddresses returns the networks no address may be allocated from:
+// leases, allowed IPs of the peers and the addresses of the device.
+func (wgs *WGServer) usedAddresses(dev *wgtypes.Device, pools *pb.AddressPools) []net.IPNet {
+	var used []net.IPNet
+	for _, l := range pools.GetLeases() {
+		used = append(used, *pb2IPNet(l.GetAddress()))
+	}
+	for _, p := range dev.Peers {
+		used = append(used, p.AllowedIPs...)
+	}
+	if wgs.l != nil {
+		if link, err := wgs.l.Link(dev.Name); err == nil {
+			for _, a := range link.Addresses {
+				bits := len(a.IP) * 8
+				if ip4 := a.IP.To4(); ip4 != nil {
+					a.IP, bits = ip4, net.IPv4len*8
+				}
+				used = append(used, net.IPNet{IP: a.IP, Mask: net.CIDRMask(bits, bits)})
+			}
+		}
+	}
+	return used
+}
+
+// nextFree returns the lowest address of prefix outside of used, or nil.
+// The network address and the IPv4 broadcast address are never returned.
+func nextFree(prefix *net.IPNet, used []net.IPNet) net.IP {
+	last := lastIP(prefix)
+	ip := nextIP(prefix.IP)
+	for ip != nil && prefix.Contains(ip) {
+		if len(ip) == net.IPv4len && ip.Equal(last) {
+			return nil
+		}
+		var taken *net.IPNet
+		for i := range used {
+			if used[i].Contains(ip) {
+				taken = &used[i]
+				break
+			}
+		}
+		if taken == nil {
+			return ip
+		}
+		// Skip the whole network, it may be large.
+		end := lastIP(taken)
+		if bytes.Compare(end.To16(), last.To16()) >= 0 {
+			return nil
+		}
+		ip = nextIP(end)
+		if len(ip) != len(prefix.IP) {
+			ip = ip.To16()
+			if len(prefix.IP) == net.IPv4len {
+				ip = ip.To4()
+			}
+		}
+	}
+	return nil
+}
+
+// overlaps reports whether two networks share addresses.
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// lastIP returns the highest address of a network.
+func lastIP(ipn *net.IPNet) net.IP {
+	ip := make(net.IP, len(ipn.IP))
+	mask := ipn.Mask
+	if len(mask) != len(ip) {
+		ip = ipn.IP.To16()
+		ip = append(net.IP(nil), ip...)
+		if len(mask) == net.IPv4len {
+			ip = ip.To4()
+		}
+	} else {
+		copy(ip, ipn.IP)
+	}
+	for i := range ip {
+		ip[i] |= ^mask[i]
+	}
+	return ip
+}
+
+// nextIP returns the address following ip, or nil if ip is the last one.
+func nextIP(ip net.IP) net.IP {
+	next := append(net.IP(nil), ip...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package wgserver
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func mustIPNet(t *testing.T, cidr string) *pb.IPNet {
+	t.Helper()
+	ip, ipn, err := net.ParseCIDR(cidr)
+	if err != nil {
+		t.Fatalf("ParseCIDR: %v", err)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return &pb.IPNet{Ip: ip, IpMask: ipn.Mask}
+}
+
+func TestAllocatePeer(t *testing.T) {
+	existing, _ := wgtypes.GenerateKey()
+	dev := &wgtypes.Device{
+		Name: "wg0",
+		Peers: []wgtypes.Peer{{
+			PublicKey:  existing,
+			AllowedIPs: []net.IPNet{{IP: net.IP{10, 7, 0, 2}, Mask: net.CIDRMask(32, 32)}},
+		}},
+	}
+	store := newTestStore(t)
+	wgs := &WGServer{
+		c: newTestKernel(dev),
+		l: &testLinkManager{LinkFunc: func(name string) (*Link, error) {
+			return &Link{Addresses: []net.IPNet{
+				{IP: net.IP{10, 7, 0, 1}, Mask: net.CIDRMask(24, 32)},
+				{IP: net.ParseIP("fd00::1"), Mask: net.CIDRMask(64, 128)},
+			}}, nil
+		}},
+		s: store,
+	}
+
+	// Without a store, leases would be lost on a restart.
+	unpersisted := &WGServer{c: wgs.c}
+	if err := unpersisted.SetAddressPools("wg0", []*pb.IPNet{mustIPNet(t, "10.7.0.0/24")}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("SetAddressPools without a store: want FailedPrecondition, got %v", err)
+	}
+	if _, _, _, err := unpersisted.AllocatePeer("wg0", nil, true); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("AllocatePeer without a store: want FailedPrecondition, got %v", err)
+	}
+
+	_, _, _, err := wgs.AllocatePeer("wg0", nil, true)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("AllocatePeer without pools: %v", err)
+	}
+	err = wgs.SetAddressPools("wg0", []*pb.IPNet{mustIPNet(t, "10.7.0.0/24"), mustIPNet(t, "10.7.0.0/29")})
+	if diff := cmp.Diff(invalidField("prefixes[1]", "overlaps prefixes[0]"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+	if err := wgs.SetAddressPools("wg0", []*pb.IPNet{mustIPNet(t, "10.7.0.0/29"), mustIPNet(t, "fd00::/125")}); err != nil {
+		t.Fatalf("SetAddressPools: %v", err)
+	}
+
+	var keys []wgtypes.Key
+	for _, want := range []string{"10.7.0.3", "10.7.0.4", "10.7.0.5", "10.7.0.6"} {
+		p, addrs, privateKey, err := wgs.AllocatePeer("wg0", nil, true)
+		if err != nil {
+			t.Fatalf("AllocatePeer: %v", err)
+		}
+		if len(privateKey) != wgtypes.KeyLen {
+			t.Fatalf("no private key returned")
+		}
+		got := make([]string, len(addrs))
+		for i, a := range addrs {
+			got[i] = pb2IPNet(a).String()
+		}
+		if diff := cmp.Diff([]string{want + "/32", fmt.Sprintf("fd00::%d/128", len(keys)+2)}, got); diff != "" {
+			t.Fatalf("unexpected addresses (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(addrs, p.AllowedIps, protocmp.Transform()); diff != "" {
+			t.Fatalf("addresses are not the allowed IPs of the peer (-want +got):\n%s", diff)
+		}
+		keys = append(keys, wgtypes.Key(p.PublicKey))
+	}
+	_, _, _, err = wgs.AllocatePeer("wg0", nil, true)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("AllocatePeer from a full pool: %v", err)
+	}
+
+	if err := wgs.RemovePeer("wg0", keys[1][:], ""); err != nil {
+		t.Fatalf("RemovePeer: %v", err)
+	}
+	// Leases are read back from the store.
+	wgs = &WGServer{c: wgs.c, l: wgs.l, s: store}
+	pools, err := wgs.AddressPools("wg0")
+	if err != nil {
+		t.Fatalf("AddressPools: %v", err)
+	}
+	if diff := cmp.Diff(6, len(pools.Leases)); diff != "" {
+		t.Fatalf("unexpected number of leases (-want +got):\n%s", diff)
+	}
+	peer := &pb.PeerConfig{PublicKey: keys[1][:]}
+	_, addrs, privateKey, err := wgs.AllocatePeer("wg0", peer, false)
+	if err != nil {
+		t.Fatalf("AllocatePeer: %v", err)
+	}
+	if privateKey != nil {
+		t.Fatalf("unexpected private key")
+	}
+	want := []*pb.IPNet{mustIPNet(t, "10.7.0.4/32"), mustIPNet(t, "fd00::3/128")}
+	if diff := cmp.Diff(want, addrs, protocmp.Transform()); diff != "" {
+		t.Fatalf("released addresses not reused (-want +got):\n%s", diff)
+	}
+	if len(peer.AllowedIps) != 0 {
+		t.Fatal("the request was modified")
+	}
+}
+
+func TestAllocatePeerConcurrent(t *testing.T) {
+	wgs := &WGServer{c: newTestKernel(&wgtypes.Device{Name: "wg0"}), s: newTestStore(t)}
+	if err := wgs.SetAddressPools("wg0", []*pb.IPNet{mustIPNet(t, "10.7.0.0/24")}); err != nil {
+		t.Fatalf("SetAddressPools: %v", err)
+	}
+
+	const n = 50
+	var wg sync.WaitGroup
+	addrs := make([]string, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, a, _, err := wgs.AllocatePeer("wg0", nil, true)
+			if err == nil {
+				addrs[i] = pb2IPNet(a[0]).String()
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+	seen := map[string]bool{}
+	for i, a := range addrs {
+		if errs[i] != nil {
+			t.Fatalf("AllocatePeer: %v", errs[i])
+		}
+		if seen[a] {
+			t.Fatalf("%s allocated twice", a)
+		}
+		seen[a] = true
+	}
+}
+
+func TestLeasesFollowPeers(t *testing.T) {
+	store := newTestStore(t)
+	wgs := &WGServer{
+		c: newTestKernel(&wgtypes.Device{Name: "wg0"}),
+		l: &testLinkManager{DeleteLinkFunc: func(name string) error { return nil }},
+		s: store,
+	}
+	if err := wgs.SetAddressPools("wg0", []*pb.IPNet{mustIPNet(t, "10.7.0.0/24")}); err != nil {
+		t.Fatalf("SetAddressPools: %v", err)
+	}
+	var keys [][]byte
+	for i := 0; i < 3; i++ {
+		p, _, _, err := wgs.AllocatePeer("wg0", nil, true)
+		if err != nil {
+			t.Fatalf("AllocatePeer: %v", err)
+		}
+		keys = append(keys, p.GetPublicKey())
+	}
+	leased := func() [][]byte {
+		t.Helper()
+		// Leases are read back from the store.
+		pools, err := (&WGServer{c: wgs.c, s: store}).AddressPools("wg0")
+		if err != nil {
+			t.Fatalf("AddressPools: %v", err)
+		}
+		var keys [][]byte
+		for _, l := range pools.GetLeases() {
+			keys = append(keys, l.GetPublicKey())
+		}
+		return keys
+	}
+
+	cfg := &pb.Config{ReplacePeers: true, Peers: []*pb.PeerConfig{{PublicKey: keys[0]}, {PublicKey: keys[1]}}}
+	if err := wgs.ConfigureDevice("wg0", cfg, ""); err != nil {
+		t.Fatalf("ConfigureDevice: %v", err)
+	}
+	if diff := cmp.Diff(keys[:2], leased()); diff != "" {
+		t.Fatalf("lease of a replaced peer kept (-want +got):\n%s", diff)
+	}
+
+	err := wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{{
+		Name:   "wg0",
+		Config: &pb.Config{Peers: []*pb.PeerConfig{{PublicKey: keys[1], Remove: true}}},
+	}})
+	if err != nil {
+		t.Fatalf("BatchConfigure: %v", err)
+	}
+	if diff := cmp.Diff(keys[:1], leased()); diff != "" {
+		t.Fatalf("lease of a removed peer kept (-want +got):\n%s", diff)
+	}
+
+	if err := wgs.DeleteDevice("wg0"); err != nil {
+		t.Fatalf("DeleteDevice: %v", err)
+	}
+	pools, err := store.LoadPools()
+	if err != nil {
+		t.Fatalf("LoadPools: %v", err)
+	}
+	if len(pools) != 0 {
+		t.Fatalf("pools of a deleted device kept in the store: %v", pools)
+	}
+	got, err := wgs.AddressPools("wg0")
+	if err != nil {
+		t.Fatalf("AddressPools: %v", err)
+	}
+	if diff := cmp.Diff(&pb.AddressPools{Name: "wg0"}, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("pools of a deleted device kept (-want +got):\n%s", diff)
+	}
+}
+
+func TestNextFree(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		used   []string
+		want   net.IP
+	}{
+		{
+			name:   "FirstHost",
+			prefix: "10.7.0.0/24",
+			want:   net.IP{10, 7, 0, 1},
+		},
+		{
+			name:   "SkipsUsedNetworks",
+			prefix: "10.7.0.0/24",
+			used:   []string{"10.7.0.1/32", "10.7.0.0/26"},
+			want:   net.IP{10, 7, 0, 64},
+		},
+		{
+			name:   "NoBroadcast",
+			prefix: "10.7.0.0/30",
+			used:   []string{"10.7.0.1/32", "10.7.0.2/32"},
+		},
+		{
+			name:   "CoveredPool",
+			prefix: "10.7.0.0/24",
+			used:   []string{"0.0.0.0/0"},
+		},
+		{
+			name:   "LargeIPv6",
+			prefix: "fd00::/64",
+			used:   []string{"fd00::/65"},
+			want:   net.ParseIP("fd00::8000:0:0:0"),
+		},
+		{
+			name:   "LastIPv6",
+			prefix: "fd00::/126",
+			used:   []string{"fd00::1/128", "fd00::2/128"},
+			want:   net.ParseIP("fd00::3"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var used []net.IPNet
+			for _, u := range tt.used {
+				used = append(used, *pb2IPNet(mustIPNet(t, u)))
+			}
+			got := nextFree(pb2IPNet(mustIPNet(t, tt.prefix)), used)
+			if !got.Equal(tt.want) {
+				t.Fatalf("nextFree = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
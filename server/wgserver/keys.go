@@ -0,0 +1,76 @@
+package wgserver
+
+import (
+	"fmt"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// GenerateKeyPair generates a private key and returns it with its public key.
+func GenerateKeyPair() (privateKey, publicKey []byte, err error) {
+	key, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating private key: %w", err)
+	}
+	pub := key.PublicKey()
+	return key[:], pub[:], nil
+}
+
+// GeneratePresharedKey generates a preshared key.
+func GeneratePresharedKey() ([]byte, error) {
+	key, err := wgtypes.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generating preshared key: %w", err)
+	}
+	return key[:], nil
+}
+
+// DerivePublicKey returns the public key of a private key.
+func DerivePublicKey(privateKey []byte) ([]byte, error) {
+	verr := &ValidationError{}
+	validateKey(verr, "private_key", privateKey, true)
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	pub := wgtypes.Key(privateKey).PublicKey()
+	return pub[:], nil
+}
+
+// AddGeneratedPeer generates a key pair for a new peer, adds the peer to a
+// WireGuard device and returns its state and private key. The private key
+// is not kept anywhere.
+//
+// The public key of peer must be empty, peer may be nil. The device revision
+// is checked like by ConfigureDevice.
+func (wgs *WGServer) AddGeneratedPeer(name string, peer *pb.PeerConfig, revision string) (*pb.Peer, []byte, error) {
+	pc, privateKey, err := generatedPeer(peer)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := wgs.AddPeer(name, pc, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, privateKey, nil
+}
+
+// generatedPeer returns a copy of peer with a generated public key and the
+// matching private key.
+func generatedPeer(peer *pb.PeerConfig) (*pb.PeerConfig, []byte, error) {
+	if len(peer.GetPublicKey()) != 0 {
+		return nil, nil, invalidField("peer.public_key", "must be empty when generate_keypair is set")
+	}
+	privateKey, publicKey, err := GenerateKeyPair()
+	if err != nil {
+		return nil, nil, err
+	}
+	pc := &pb.PeerConfig{}
+	if peer != nil {
+		pc = proto.Clone(peer).(*pb.PeerConfig)
+	}
+	pc.PublicKey = publicKey
+	return pc, privateKey, nil
+}
@@ -0,0 +1,61 @@
+package wgserver
+
+import (
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+func TestKeys(t *testing.T) {
+	priv, pub, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+	derived, err := DerivePublicKey(priv)
+	if err != nil {
+		t.Fatalf("DerivePublicKey: %v", err)
+	}
+	if diff := cmp.Diff(pub, derived); diff != "" {
+		t.Fatalf("unexpected public key (-want +got):\n%s", diff)
+	}
+	_, err = DerivePublicKey([]byte{1, 2, 3})
+	if diff := cmp.Diff(invalidField("private_key", "must be 32 bytes, got 3"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+
+	psk, err := GeneratePresharedKey()
+	if err != nil {
+		t.Fatalf("GeneratePresharedKey: %v", err)
+	}
+	if diff := cmp.Diff(wgtypes.KeyLen, len(psk)); diff != "" {
+		t.Fatalf("unexpected preshared key length (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddGeneratedPeer(t *testing.T) {
+	wgs := WGServer{c: newTestKernel(&wgtypes.Device{Name: "wg0"})}
+	peer := &pb.PeerConfig{AllowedIps: []*pb.IPNet{{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}}}
+
+	p, privateKey, err := wgs.AddGeneratedPeer("wg0", peer, "")
+	if err != nil {
+		t.Fatalf("AddGeneratedPeer: %v", err)
+	}
+	pub, _ := DerivePublicKey(privateKey)
+	if diff := cmp.Diff(pub, p.PublicKey); diff != "" {
+		t.Fatalf("peer does not use the generated key (-want +got):\n%s", diff)
+	}
+	if len(peer.PublicKey) != 0 {
+		t.Fatal("the request was modified")
+	}
+	if _, _, err := wgs.AddGeneratedPeer("wg0", nil, ""); err != nil {
+		t.Fatalf("AddGeneratedPeer without a configuration: %v", err)
+	}
+
+	_, _, err = wgs.AddGeneratedPeer("wg0", &pb.PeerConfig{PublicKey: pub}, "")
+	if diff := cmp.Diff(invalidField("peer.public_key", "must be empty when generate_keypair is set"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+}
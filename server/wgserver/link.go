@@ -0,0 +1,216 @@
+package wgserver
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"unicode"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+)
+
+const (
+	// maxLinkNameLen is the longest interface name the kernel accepts (IFNAMSIZ-1).
+	maxLinkNameLen = 15
+	// minMTU and maxMTU are the MTU limits of a link.
+	minMTU = 68
+	maxMTU = 65535
+	// iffUp is the IFF_UP link flag.
+	iffUp = 0x1
+)
+
+// ErrUnsupported is returned by operations which are not available on this platform.
+var ErrUnsupported = errors.New("operation is not supported on this platform")
+
+// LinkManager manages network links backing WireGuard devices.
+type LinkManager interface {
+	io.Closer
+	// CreateLink creates a WireGuard link.
+	//
+	// An error matching os.ErrExist is returned if the link already exists.
+	CreateLink(name string) error
+	// DeleteLink deletes a link.
+	//
+	// An error matching os.ErrNotExist is returned if the link does not exist.
+	DeleteLink(name string) error
+	// Link returns the state of a link.
+	//
+	// An error matching os.ErrNotExist is returned if the link does not exist.
+	Link(name string) (*Link, error)
+	// AddAddress assigns an address to a link.
+	AddAddress(name string, addr net.IPNet) error
+	// DeleteAddress removes an address from a link.
+	DeleteAddress(name string, addr net.IPNet) error
+	// SetMTU sets the MTU of a link.
+	SetMTU(name string, mtu int) error
+	// SetUp brings a link up or down.
+	SetUp(name string, up bool) error
+}
+
+// Link describes the state of a network link.
+type Link struct {
+	Index     int
+	MTU       int
+	Flags     uint32
+	Addresses []net.IPNet
+}
+
+// AddAddress assigns an address to a WireGuard device.
+func (wgs *WGServer) AddAddress(name string, addr *pb.IPNet) error {
+	ipn, err := wgs.addressRequest(name, addr)
+	if err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	if err := wgs.l.AddAddress(name, *ipn); err != nil {
+		return err
+	}
+	return wgs.record(name)
+}
+
+// RemoveAddress removes an address from a WireGuard device.
+//
+// Removing an address which is not assigned succeeds.
+func (wgs *WGServer) RemoveAddress(name string, addr *pb.IPNet) error {
+	ipn, err := wgs.addressRequest(name, addr)
+	if err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	link, err := wgs.l.Link(name)
+	if err != nil {
+		return err
+	}
+	if !containsAddress(link.Addresses, *ipn) {
+		return nil
+	}
+	if err := wgs.l.DeleteAddress(name, *ipn); err != nil {
+		return err
+	}
+	return wgs.record(name)
+}
+
+// SetMTU sets the MTU of a WireGuard device.
+func (wgs *WGServer) SetMTU(name string, mtu int32) error {
+	verr := &ValidationError{}
+	validateLinkName(verr, "name", name)
+	validateMTU(verr, "mtu", mtu)
+	if err := verr.err(); err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	if _, err := wgs.c.Device(name); err != nil {
+		return err
+	}
+	if err := wgs.l.SetMTU(name, int(mtu)); err != nil {
+		return err
+	}
+	return wgs.record(name)
+}
+
+// SetLinkState brings a WireGuard device up or down.
+func (wgs *WGServer) SetLinkState(name string, up bool) error {
+	verr := &ValidationError{}
+	validateLinkName(verr, "name", name)
+	if err := verr.err(); err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	if _, err := wgs.c.Device(name); err != nil {
+		return err
+	}
+	if err := wgs.l.SetUp(name, up); err != nil {
+		return err
+	}
+	return wgs.record(name)
+}
+
+// addressRequest validates an address request and makes sure only
+// WireGuard devices are changed.
+func (wgs *WGServer) addressRequest(name string, addr *pb.IPNet) (*net.IPNet, error) {
+	ipn := pb2IPNet(addr)
+	verr := &ValidationError{}
+	validateLinkName(verr, "name", name)
+	validateAddress(verr, "address", ipn)
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	if _, err := wgs.c.Device(name); err != nil {
+		return nil, err
+	}
+	return ipn, nil
+}
+
+// setLinkInfo adds the link state to dev. Failures are only logged since
+// devices on some platforms have no link visible to LinkManager.
+func (wgs *WGServer) setLinkInfo(dev *pb.Device) {
+	if wgs.l == nil {
+		return
+	}
+	link, err := wgs.l.Link(dev.Name)
+	if err != nil {
+		if !errors.Is(err, ErrUnsupported) {
+			log.Printf("Link %s: %s", dev.Name, err)
+		}
+		return
+	}
+	dev.Index = int32(link.Index)
+	dev.Mtu = int32(link.MTU)
+	dev.Flags = link.Flags
+	dev.Up = link.Flags&iffUp != 0
+	dev.Addresses = make([]*pb.IPNet, 0, len(link.Addresses))
+	for _, a := range link.Addresses {
+		dev.Addresses = append(dev.Addresses, &pb.IPNet{Ip: a.IP, IpMask: a.Mask})
+	}
+}
+
+// validateLinkName checks name against the kernel rules for interface names.
+func validateLinkName(verr *ValidationError, field string, name string) {
+	switch {
+	case name == "":
+		verr.add(field, "must not be empty")
+	case len(name) > maxLinkNameLen:
+		verr.add(field, "must not be longer than %d bytes, got %d", maxLinkNameLen, len(name))
+	case name == "." || name == "..":
+		verr.add(field, "must not be %q", name)
+	case strings.IndexFunc(name, func(r rune) bool { return r == '/' || r == ':' || unicode.IsSpace(r) }) >= 0:
+		verr.add(field, "must not contain '/', ':' or whitespace")
+	}
+}
+
+func validateMTU(verr *ValidationError, field string, mtu int32) {
+	if mtu < minMTU || mtu > maxMTU {
+		verr.add(field, "must be between %d and %d, got %d", minMTU, maxMTU, mtu)
+	}
+}
+
+// validateAddress checks an interface address. Unlike allowed IPs, interface
+// addresses keep their host bits.
+func validateAddress(verr *ValidationError, field string, addr *net.IPNet) {
+	if addr == nil {
+		verr.add(field, "is required")
+		return
+	}
+	if !validateIP(verr, field+".ip", addr.IP) {
+		return
+	}
+	if len(addr.Mask) != len(addr.IP) {
+		verr.add(field+".ip_mask", "must be %d bytes like the ip, got %d", len(addr.IP), len(addr.Mask))
+		return
+	}
+	if _, bits := addr.Mask.Size(); bits == 0 {
+		verr.add(field+".ip_mask", "%s is not a prefix mask", addr.Mask)
+	}
+}
+
+// containsAddress reports whether addrs contains addr with the same prefix length.
+func containsAddress(addrs []net.IPNet, addr net.IPNet) bool {
+	for _, a := range addrs {
+		if a.IP.Equal(addr.IP) && a.Mask.String() == addr.Mask.String() {
+			return true
+		}
+	}
+	return false
+}
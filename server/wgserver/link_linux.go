@@ -0,0 +1,163 @@
+//go:build linux
+
+package wgserver
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/jsimonetti/rtnetlink"
+	"golang.org/x/sys/unix"
+)
+
+// rtnlLinkManager manages links via rtnetlink.
+type rtnlLinkManager struct {
+	c *rtnetlink.Conn
+}
+
+func newLinkManager() (LinkManager, error) {
+	c, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("dial rtnetlink: %w", err)
+	}
+	return &rtnlLinkManager{c: c}, nil
+}
+
+func (m *rtnlLinkManager) Close() error {
+	return m.c.Close()
+}
+
+func (m *rtnlLinkManager) CreateLink(name string) error {
+	return m.c.Link.New(&rtnetlink.LinkMessage{
+		Family: unix.AF_UNSPEC,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: name,
+			Info: &rtnetlink.LinkInfo{Kind: "wireguard"},
+		},
+	})
+}
+
+func (m *rtnlLinkManager) DeleteLink(name string) error {
+	link, err := m.linkByName(name)
+	if err != nil {
+		return err
+	}
+	return m.c.Link.Delete(link.Index)
+}
+
+func (m *rtnlLinkManager) Link(name string) (*Link, error) {
+	link, err := m.linkByName(name)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := m.c.Address.List()
+	if err != nil {
+		return nil, err
+	}
+	l := &Link{
+		Index: int(link.Index),
+		MTU:   int(link.Attributes.MTU),
+		Flags: link.Flags,
+	}
+	for _, a := range addrs {
+		if a.Index != link.Index || a.Attributes == nil {
+			continue
+		}
+		ip := a.Attributes.Address
+		l.Addresses = append(l.Addresses, net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(int(a.PrefixLength), 8*len(ip)),
+		})
+	}
+	return l, nil
+}
+
+func (m *rtnlLinkManager) AddAddress(name string, addr net.IPNet) error {
+	msg, err := m.addressMessage(name, addr)
+	if err != nil {
+		return err
+	}
+	return m.c.Address.New(msg)
+}
+
+func (m *rtnlLinkManager) DeleteAddress(name string, addr net.IPNet) error {
+	msg, err := m.addressMessage(name, addr)
+	if err != nil {
+		return err
+	}
+	return m.c.Address.Delete(msg)
+}
+
+func (m *rtnlLinkManager) SetMTU(name string, mtu int) error {
+	link, err := m.linkByName(name)
+	if err != nil {
+		return err
+	}
+	return m.c.Link.Set(&rtnetlink.LinkMessage{
+		Family:     unix.AF_UNSPEC,
+		Type:       link.Type,
+		Index:      link.Index,
+		Attributes: &rtnetlink.LinkAttributes{MTU: uint32(mtu)},
+	})
+}
+
+func (m *rtnlLinkManager) SetUp(name string, up bool) error {
+	link, err := m.linkByName(name)
+	if err != nil {
+		return err
+	}
+	var flags uint32
+	if up {
+		flags = unix.IFF_UP
+	}
+	return m.c.Link.Set(&rtnetlink.LinkMessage{
+		Family: unix.AF_UNSPEC,
+		Type:   link.Type,
+		Index:  link.Index,
+		Flags:  flags,
+		Change: unix.IFF_UP,
+	})
+}
+
+// linkByName looks a link up by its name.
+func (m *rtnlLinkManager) linkByName(name string) (*rtnetlink.LinkMessage, error) {
+	links, err := m.c.Link.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range links {
+		if links[i].Attributes != nil && links[i].Attributes.Name == name {
+			return &links[i], nil
+		}
+	}
+	return nil, fmt.Errorf("link %q: %w", name, os.ErrNotExist)
+}
+
+// addressMessage builds a request to add or remove addr on the link.
+func (m *rtnlLinkManager) addressMessage(name string, addr net.IPNet) (*rtnetlink.AddressMessage, error) {
+	link, err := m.linkByName(name)
+	if err != nil {
+		return nil, err
+	}
+	ones, _ := addr.Mask.Size()
+	msg := &rtnetlink.AddressMessage{
+		Family:       unix.AF_INET6,
+		PrefixLength: uint8(ones),
+		Scope:        unix.RT_SCOPE_UNIVERSE,
+		Index:        link.Index,
+		Attributes: &rtnetlink.AddressAttributes{
+			Address: addr.IP,
+			Local:   addr.IP,
+		},
+	}
+	if ip4 := addr.IP.To4(); ip4 != nil {
+		msg.Family = unix.AF_INET
+		msg.Attributes.Address = ip4
+		msg.Attributes.Local = ip4
+	}
+	if addr.IP.IsLinkLocalUnicast() {
+		msg.Scope = unix.RT_SCOPE_LINK
+	}
+	return msg, nil
+}
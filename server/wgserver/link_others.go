@@ -0,0 +1,23 @@
+//go:build !linux
+
+package wgserver
+
+import "net"
+
+// unsupportedLinkManager is used on platforms without rtnetlink.
+type unsupportedLinkManager struct{}
+
+func newLinkManager() (LinkManager, error) {
+	return unsupportedLinkManager{}, nil
+}
+
+func (unsupportedLinkManager) Close() error                                 { return nil }
+func (unsupportedLinkManager) CreateLink(name string) error                 { return ErrUnsupported }
+func (unsupportedLinkManager) DeleteLink(name string) error                 { return ErrUnsupported }
+func (unsupportedLinkManager) Link(name string) (*Link, error)              { return nil, ErrUnsupported }
+func (unsupportedLinkManager) AddAddress(name string, addr net.IPNet) error { return ErrUnsupported }
+func (unsupportedLinkManager) DeleteAddress(name string, addr net.IPNet) error {
+	return ErrUnsupported
+}
+func (unsupportedLinkManager) SetMTU(name string, mtu int) error { return ErrUnsupported }
+func (unsupportedLinkManager) SetUp(name string, up bool) error  { return ErrUnsupported }
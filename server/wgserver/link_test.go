@@ -0,0 +1,374 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestValidateLinkName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []FieldViolation
+	}{
+		{name: "ok", in: "wg0"},
+		{name: "max length", in: "wg-0123456789ab"},
+		{name: "empty", in: "", want: []FieldViolation{{Field: "name", Description: "must not be empty"}}},
+		{name: "too long", in: "wg-0123456789abc", want: []FieldViolation{{Field: "name", Description: "must not be longer than 15 bytes, got 16"}}},
+		{name: "dot", in: ".", want: []FieldViolation{{Field: "name", Description: `must not be "."`}}},
+		{name: "slash", in: "wg/0", want: []FieldViolation{{Field: "name", Description: "must not contain '/', ':' or whitespace"}}},
+		{name: "space", in: "wg 0", want: []FieldViolation{{Field: "name", Description: "must not contain '/', ':' or whitespace"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr := &ValidationError{}
+			validateLinkName(verr, "name", tt.in)
+			if diff := cmp.Diff(tt.want, verr.Violations); diff != "" {
+				t.Fatalf("unexpected violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateDevice(t *testing.T) {
+	var (
+		linkExists = func(name string) error { return fmt.Errorf("link %s: %w", name, os.ErrExist) }
+		linkOk     = func(name string) error { return nil }
+		cfgOk      = func(_ string, _ wgtypes.Config) error { return nil }
+		cfgFailed  = func(_ string, _ wgtypes.Config) error { return os.ErrPermission }
+		cfg        = &pb.Config{ListenPort: proto.Int32(51820)}
+		saveFailed = failingStore{err: os.ErrPermission}
+	)
+
+	tests := []struct {
+		name        string
+		devName     string
+		cfg         *pb.Config
+		createFn    func(string) error
+		configureFn func(string, wgtypes.Config) error
+		store       Store
+		wantDeleted []string
+		err         error
+	}{
+		{
+			name:     "ok without config",
+			devName:  "wg0",
+			createFn: linkOk,
+		},
+		{
+			name:        "ok with config",
+			devName:     "wg0",
+			cfg:         cfg,
+			createFn:    linkOk,
+			configureFn: cfgOk,
+		},
+		{
+			name:     "exists",
+			devName:  "wg0",
+			createFn: linkExists,
+			err:      fmt.Errorf("link wg0: %w", os.ErrExist),
+		},
+		{
+			name:        "config failed removes link",
+			devName:     "wg0",
+			cfg:         cfg,
+			createFn:    linkOk,
+			configureFn: cfgFailed,
+			wantDeleted: []string{"wg0"},
+			err:         os.ErrPermission,
+		},
+		{
+			name:        "recording failed removes link",
+			devName:     "wg0",
+			cfg:         cfg,
+			createFn:    linkOk,
+			configureFn: cfgOk,
+			store:       saveFailed,
+			wantDeleted: []string{"wg0"},
+			err:         fmt.Errorf("save desired state of wg0: %w", os.ErrPermission),
+		},
+		{
+			name:     "invalid name and config",
+			devName:  "",
+			cfg:      &pb.Config{PrivateKey: []byte{1}},
+			createFn: linkOk,
+			err: &ValidationError{Violations: []FieldViolation{
+				{Field: "name", Description: "must not be empty"},
+				{Field: "config.private_key", Description: "must be 32 bytes, got 1"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			wgs := WGServer{
+				c: &testClient{
+					ConfigureDeviceFunc: tt.configureFn,
+					DeviceFunc: func(name string) (*wgtypes.Device, error) {
+						return &wgtypes.Device{Name: name}, nil
+					},
+				},
+				l: &testLinkManager{
+					CreateLinkFunc: tt.createFn,
+					DeleteLinkFunc: func(name string) error { deleted = append(deleted, name); return nil },
+				},
+				s: tt.store,
+			}
+			dev, err := wgs.CreateDevice(tt.devName, tt.cfg)
+			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, deleted); diff != "" {
+				t.Fatalf("unexpected deleted links (-want +got):\n%s", diff)
+			}
+			if err == nil && dev.GetName() != tt.devName {
+				t.Fatalf("unexpected device name: %q", dev.GetName())
+			}
+		})
+	}
+}
+
+func TestDeleteDevice(t *testing.T) {
+	tests := []struct {
+		name        string
+		devName     string
+		deviceFn    func(string) (*wgtypes.Device, error)
+		wantDeleted []string
+		err         error
+	}{
+		{
+			name:    "ok",
+			devName: "wg0",
+			deviceFn: func(name string) (*wgtypes.Device, error) {
+				return &wgtypes.Device{Name: name}, nil
+			},
+			wantDeleted: []string{"wg0"},
+		},
+		{
+			name:    "not a wireguard device",
+			devName: "eth0",
+			deviceFn: func(name string) (*wgtypes.Device, error) {
+				return nil, os.ErrNotExist
+			},
+			err: os.ErrNotExist,
+		},
+		{
+			name:    "empty name",
+			devName: "",
+			err:     invalidField("name", "must not be empty"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			wgs := WGServer{
+				c: &testClient{DeviceFunc: tt.deviceFn},
+				l: &testLinkManager{
+					DeleteLinkFunc: func(name string) error { deleted = append(deleted, name); return nil },
+				},
+			}
+			err := wgs.DeleteDevice(tt.devName)
+			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, deleted); diff != "" {
+				t.Fatalf("unexpected deleted links (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeviceLinkInfo(t *testing.T) {
+	_, addr, _ := net.ParseCIDR("10.7.0.0/24")
+	addr.IP = net.IP{10, 7, 0, 1}
+	wgs := WGServer{
+		c: &testClient{DeviceFunc: func(name string) (*wgtypes.Device, error) {
+			return &wgtypes.Device{Name: name}, nil
+		}},
+		l: &testLinkManager{LinkFunc: func(name string) (*Link, error) {
+			return &Link{Index: 5, MTU: 1420, Flags: iffUp | 0x10, Addresses: []net.IPNet{*addr}}, nil
+		}},
+	}
+	dev, err := wgs.Device("wg0")
+	if err != nil {
+		t.Fatalf("Device: %v", err)
+	}
+	want := &pb.Device{
+		Name:      "wg0",
+		PublicKey: make([]byte, wgtypes.KeyLen),
+		Index:     5,
+		Mtu:       1420,
+		Flags:     0x11,
+		Up:        true,
+		Addresses: []*pb.IPNet{{Ip: []byte{10, 7, 0, 1}, IpMask: []byte{255, 255, 255, 0}}},
+		Revision:  revision(&wgtypes.Device{Name: "wg0"}),
+	}
+	dev.PrivateKey = nil
+	if diff := cmp.Diff(want, dev, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected device (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddresses(t *testing.T) {
+	var (
+		assigned = net.IPNet{IP: net.IP{10, 7, 0, 1}, Mask: net.CIDRMask(24, 32)}
+		other    = &pb.IPNet{Ip: []byte{10, 8, 0, 1}, IpMask: []byte{255, 255, 255, 0}}
+	)
+
+	tests := []struct {
+		name        string
+		remove      bool
+		addr        *pb.IPNet
+		wantAdded   []net.IPNet
+		wantDeleted []net.IPNet
+		err         error
+	}{
+		{
+			name:      "add with host bits",
+			addr:      other,
+			wantAdded: []net.IPNet{{IP: net.IP{10, 8, 0, 1}, Mask: net.CIDRMask(24, 32)}},
+		},
+		{
+			name:        "remove assigned",
+			remove:      true,
+			addr:        &pb.IPNet{Ip: assigned.IP, IpMask: assigned.Mask},
+			wantDeleted: []net.IPNet{assigned},
+		},
+		{
+			name:   "remove missing is a no-op",
+			remove: true,
+			addr:   other,
+		},
+		{
+			name: "missing address",
+			err:  invalidField("address", "is required"),
+		},
+		{
+			name: "mismatched mask",
+			addr: &pb.IPNet{Ip: []byte{10, 7, 0, 1}, IpMask: net.CIDRMask(64, 128)},
+			err:  invalidField("address.ip_mask", "must be 4 bytes like the ip, got 16"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added, deleted []net.IPNet
+			wgs := WGServer{
+				c: &testClient{DeviceFunc: func(name string) (*wgtypes.Device, error) {
+					return &wgtypes.Device{Name: name}, nil
+				}},
+				l: &testLinkManager{
+					LinkFunc: func(name string) (*Link, error) {
+						return &Link{Addresses: []net.IPNet{assigned}}, nil
+					},
+					AddAddressFunc:    func(_ string, a net.IPNet) error { added = append(added, a); return nil },
+					DeleteAddressFunc: func(_ string, a net.IPNet) error { deleted = append(deleted, a); return nil },
+				},
+			}
+			var err error
+			if tt.remove {
+				err = wgs.RemoveAddress("wg0", tt.addr)
+			} else {
+				err = wgs.AddAddress("wg0", tt.addr)
+			}
+			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantAdded, added); diff != "" {
+				t.Fatalf("unexpected added addresses (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, deleted); diff != "" {
+				t.Fatalf("unexpected deleted addresses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetMTUAndLinkState(t *testing.T) {
+	var (
+		mtu int
+		up  bool
+	)
+	wgs := WGServer{
+		c: &testClient{DeviceFunc: func(name string) (*wgtypes.Device, error) {
+			if name != "wg0" {
+				return nil, os.ErrNotExist
+			}
+			return &wgtypes.Device{Name: name}, nil
+		}},
+		l: &testLinkManager{
+			SetMTUFunc: func(_ string, v int) error { mtu = v; return nil },
+			SetUpFunc:  func(_ string, v bool) error { up = v; return nil },
+		},
+	}
+
+	if err := wgs.SetMTU("wg0", 1420); err != nil {
+		t.Fatalf("SetMTU: %v", err)
+	}
+	if diff := cmp.Diff(1420, mtu); diff != "" {
+		t.Errorf("unexpected MTU (-want +got):\n%s", diff)
+	}
+	err := wgs.SetMTU("wg0", 20)
+	if diff := cmp.Diff(invalidField("mtu", "must be between 68 and 65535, got 20"), err, cmpErrors); diff != "" {
+		t.Errorf("unexpected error (-want +got):\n%s", diff)
+	}
+	if err := wgs.SetLinkState("wg0", true); err != nil {
+		t.Fatalf("SetLinkState: %v", err)
+	}
+	if !up {
+		t.Errorf("link is not up")
+	}
+	if err := wgs.SetLinkState("eth0", false); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("unexpected error for a non-WireGuard link: %v", err)
+	}
+}
+
+type testLinkManager struct {
+	CloseFunc         func() error
+	CreateLinkFunc    func(name string) error
+	DeleteLinkFunc    func(name string) error
+	LinkFunc          func(name string) (*Link, error)
+	AddAddressFunc    func(name string, addr net.IPNet) error
+	DeleteAddressFunc func(name string, addr net.IPNet) error
+	SetMTUFunc        func(name string, mtu int) error
+	SetUpFunc         func(name string, up bool) error
+}
+
+func (m *testLinkManager) Close() error                 { return m.CloseFunc() }
+func (m *testLinkManager) CreateLink(name string) error { return m.CreateLinkFunc(name) }
+func (m *testLinkManager) DeleteLink(name string) error { return m.DeleteLinkFunc(name) }
+func (m *testLinkManager) Link(name string) (*Link, error) {
+	// Device lookups ask for the link state, which most tests don't care about.
+	if m.LinkFunc == nil {
+		return nil, ErrUnsupported
+	}
+	return m.LinkFunc(name)
+}
+func (m *testLinkManager) AddAddress(name string, addr net.IPNet) error {
+	return m.AddAddressFunc(name, addr)
+}
+func (m *testLinkManager) DeleteAddress(name string, addr net.IPNet) error {
+	return m.DeleteAddressFunc(name, addr)
+}
+func (m *testLinkManager) SetMTU(name string, mtu int) error { return m.SetMTUFunc(name, mtu) }
+func (m *testLinkManager) SetUp(name string, up bool) error  { return m.SetUpFunc(name, up) }
+
+// failingStore fails to save the desired state of devices.
+type failingStore struct {
+	Store
+	err error
+}
+
+func (s failingStore) Save(*pb.DesiredDevice) error { return s.err }
@@ -0,0 +1,70 @@
+package wgserver
+
+import (
+	"sort"
+	"sync"
+)
+
+// deviceLocks serializes the changes of every device, so a change based on
+// the current state of a device, like a revision check, can not interleave
+// with another change of the same device. Reads don't take the locks.
+type deviceLocks struct {
+	mu    sync.Mutex
+	locks map[string]*deviceLock
+}
+
+// deviceLock is removed from deviceLocks once no caller holds or waits for
+// it, so locks of arbitrary names don't pile up.
+type deviceLock struct {
+	sync.Mutex
+	// refs counts the callers holding or waiting for the lock, guarded by
+	// deviceLocks.mu.
+	refs int
+}
+
+// lockDevices locks the devices in the order of their names, so callers
+// locking several devices can't deadlock each other, and returns the
+// function which unlocks them.
+//
+// A device lock is taken before the ipam lock, never the other way around.
+func (wgs *WGServer) lockDevices(names ...string) (unlock func()) {
+	sorted := make([]string, 0, len(names))
+	for _, name := range names {
+		if !contains(sorted, name) {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+
+	l := &wgs.locks
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*deviceLock{}
+	}
+	locked := make([]*deviceLock, 0, len(sorted))
+	for _, name := range sorted {
+		dl, ok := l.locks[name]
+		if !ok {
+			dl = &deviceLock{}
+			l.locks[name] = dl
+		}
+		dl.refs++
+		locked = append(locked, dl)
+	}
+	l.mu.Unlock()
+
+	for _, dl := range locked {
+		dl.Lock()
+	}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for i := len(locked) - 1; i >= 0; i-- {
+			dl := locked[i]
+			dl.Unlock()
+			if dl.refs--; dl.refs == 0 {
+				delete(l.locks, sorted[i])
+			}
+		}
+	}
+}
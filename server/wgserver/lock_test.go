@@ -0,0 +1,102 @@
+package wgserver
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestRevisionCheckIsAtomic adds peers from many goroutines, all expecting
+// the same revision. Exactly one of them may succeed.
+func TestRevisionCheckIsAtomic(t *testing.T) {
+	dev := &wgtypes.Device{Name: "wg0"}
+	wgs := WGServer{c: newSlowKernel(dev)}
+	rev := revision(dev)
+
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key, _ := wgtypes.GenerateKey()
+			_, errs[i] = wgs.AddPeer("wg0", &pb.PeerConfig{PublicKey: key[:]}, rev)
+		}(i)
+	}
+	wg.Wait()
+	added := 0
+	for _, err := range errs {
+		switch status.Code(err) {
+		case codes.OK:
+			added++
+		case codes.Aborted:
+		default:
+			t.Fatalf("AddPeer: %v", err)
+		}
+	}
+	if added != 1 || len(dev.Peers) != 1 {
+		t.Fatalf("%d AddPeer calls succeeded and the device has %d peers, want 1", added, len(dev.Peers))
+	}
+}
+
+// TestLockDevicesOrder runs batches locking the same devices in opposite
+// orders next to single device changes. None of them may deadlock.
+func TestLockDevicesOrder(t *testing.T) {
+	wgs := WGServer{c: newTestKernel(&wgtypes.Device{Name: "wg0"}, &wgtypes.Device{Name: "wg1"})}
+	step := func(name string, port int32) *pb.ConfigureDeviceRequest {
+		return &pb.ConfigureDeviceRequest{Name: name, Config: &pb.Config{ListenPort: proto.Int32(port)}}
+	}
+
+	done := make(chan error)
+	const n = 20
+	for i := 0; i < n; i++ {
+		go func() {
+			done <- wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{step("wg0", 51820), step("wg1", 51821)})
+		}()
+		go func() {
+			done <- wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{step("wg1", 51822), step("wg0", 51823)})
+		}()
+		go func() { done <- wgs.ConfigureDevice("wg1", &pb.Config{ListenPort: proto.Int32(51824)}, "") }()
+	}
+	timeout := time.After(10 * time.Second)
+	for i := 0; i < 3*n; i++ {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("configure: %v", err)
+			}
+		case <-timeout:
+			t.Fatal("deadlock")
+		}
+	}
+
+	// Locks are dropped once released, names of unknown devices included.
+	if err := wgs.ConfigureDevice("wg9", &pb.Config{}, ""); err == nil {
+		t.Fatal("ConfigureDevice of an unknown device succeeded")
+	}
+	if n := len(wgs.locks.locks); n != 0 {
+		t.Fatalf("%d device locks are left", n)
+	}
+}
+
+// newSlowKernel returns a test kernel which takes a while to read a device,
+// so concurrent changes based on the read interleave unless they are
+// serialized.
+func newSlowKernel(devices ...*wgtypes.Device) *testClient {
+	c := newTestKernel(devices...)
+	device := c.DeviceFunc
+	c.DeviceFunc = func(name string) (*wgtypes.Device, error) {
+		dev, err := device(name)
+		time.Sleep(time.Millisecond)
+		return dev, err
+	}
+	return c
+}
@@ -0,0 +1,180 @@
+package wgserver
+
+import (
+	"fmt"
+	"os"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// AddPeer adds a peer to a WireGuard device and returns its state.
+//
+// An error matching os.ErrExist is returned if a peer with the same public key
+// is already configured. The device revision is checked like by
+// ConfigureDevice.
+func (wgs *WGServer) AddPeer(name string, peer *pb.PeerConfig, revision string) (*pb.Peer, error) {
+	pc, err := peerRequest(name, peer)
+	if err != nil {
+		return nil, err
+	}
+	defer wgs.lockDevices(name)()
+	return wgs.addPeer(name, pc, revision)
+}
+
+// addPeer adds a validated peer. The device lock must be held.
+func (wgs *WGServer) addPeer(name string, pc wgtypes.PeerConfig, revision string) (*pb.Peer, error) {
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(dev, revision); err != nil {
+		return nil, err
+	}
+	if findPeer(dev, pc.PublicKey) != nil {
+		return nil, fmt.Errorf("peer %s on %s: %w", pc.PublicKey, name, os.ErrExist)
+	}
+	if err := wgs.c.ConfigureDevice(name, wgtypes.Config{Peers: []wgtypes.PeerConfig{pc}}); err != nil {
+		return nil, err
+	}
+	if err := wgs.record(name); err != nil {
+		return nil, err
+	}
+	return wgs.peer(name, pc.PublicKey)
+}
+
+// UpdatePeer applies a configuration to an existing peer and returns its state.
+//
+// An error matching os.ErrNotExist is returned if the device or the peer
+// does not exist. The device revision is checked like by ConfigureDevice.
+func (wgs *WGServer) UpdatePeer(name string, peer *pb.PeerConfig, revision string) (*pb.Peer, error) {
+	pc, err := peerRequest(name, peer)
+	if err != nil {
+		return nil, err
+	}
+	defer wgs.lockDevices(name)()
+	if err := wgs.checkDeviceRevision(name, revision); err != nil {
+		return nil, err
+	}
+	if _, err := wgs.peer(name, pc.PublicKey); err != nil {
+		return nil, err
+	}
+	pc.UpdateOnly = true
+	if err := wgs.c.ConfigureDevice(name, wgtypes.Config{Peers: []wgtypes.PeerConfig{pc}}); err != nil {
+		return nil, err
+	}
+	if err := wgs.record(name); err != nil {
+		return nil, err
+	}
+	return wgs.peer(name, pc.PublicKey)
+}
+
+// RemovePeer removes a peer from a WireGuard device.
+//
+// Removing a peer which is not configured succeeds. Addresses leased to the
+// peer by AllocatePeer are released. The device revision is checked like
+// by ConfigureDevice.
+func (wgs *WGServer) RemovePeer(name string, publicKey []byte, revision string) error {
+	key, err := peerKeyRequest(name, publicKey)
+	if err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	if err := wgs.checkDeviceRevision(name, revision); err != nil {
+		return err
+	}
+	err = wgs.c.ConfigureDevice(name, wgtypes.Config{
+		Peers: []wgtypes.PeerConfig{{PublicKey: key, Remove: true}},
+	})
+	if err != nil {
+		return err
+	}
+	if err := wgs.releaseLeases(name, key); err != nil {
+		return err
+	}
+	return wgs.record(name)
+}
+
+// Peer retrieves a single peer of a WireGuard device by its public key.
+//
+// An error matching os.ErrNotExist is returned if the device or the peer
+// does not exist.
+func (wgs *WGServer) Peer(name string, publicKey []byte) (*pb.Peer, error) {
+	key, err := peerKeyRequest(name, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return wgs.peer(name, key)
+}
+
+// Peers retrieves all peers of a WireGuard device.
+func (wgs *WGServer) Peers(name string) ([]*pb.Peer, error) {
+	if name == "" {
+		return nil, invalidField("name", "must not be empty")
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*pb.Peer, 0, len(dev.Peers))
+	for i := range dev.Peers {
+		peers = append(peers, convertWGPeerToPb(&dev.Peers[i]))
+	}
+	return peers, nil
+}
+
+func (wgs *WGServer) peer(name string, key wgtypes.Key) (*pb.Peer, error) {
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, err
+	}
+	p := findPeer(dev, key)
+	if p == nil {
+		return nil, fmt.Errorf("peer %s on %s: %w", key, name, os.ErrNotExist)
+	}
+	return convertWGPeerToPb(p), nil
+}
+
+// findPeer returns the peer of dev with the public key, or nil.
+func findPeer(dev *wgtypes.Device, key wgtypes.Key) *wgtypes.Peer {
+	for i := range dev.Peers {
+		if dev.Peers[i].PublicKey == key {
+			return &dev.Peers[i]
+		}
+	}
+	return nil
+}
+
+// peerRequest validates a request to add or update a single peer.
+func peerRequest(name string, peer *pb.PeerConfig) (wgtypes.PeerConfig, error) {
+	verr := &ValidationError{}
+	if name == "" {
+		verr.add("name", "must not be empty")
+	}
+	if peer == nil {
+		verr.add("peer", "is required")
+		return wgtypes.PeerConfig{}, verr
+	}
+	validatePeerConfig(verr, "peer", peer)
+	if peer.GetRemove() {
+		verr.add("peer.remove", "must not be set, use RemovePeer")
+	}
+	if err := verr.err(); err != nil {
+		return wgtypes.PeerConfig{}, err
+	}
+	return pbPeerConfig2wgPeerConfig(peer), nil
+}
+
+// peerKeyRequest validates a request addressing a peer by its public key.
+func peerKeyRequest(name string, publicKey []byte) (wgtypes.Key, error) {
+	verr := &ValidationError{}
+	if name == "" {
+		verr.add("name", "must not be empty")
+	}
+	validateKey(verr, "public_key", publicKey, true)
+	if err := verr.err(); err != nil {
+		return wgtypes.Key{}, err
+	}
+	return *pbKey2wgKey(publicKey), nil
+}
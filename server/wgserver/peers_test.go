@@ -0,0 +1,220 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestPeers(t *testing.T) {
+	existing, _ := wgtypes.GenerateKey()
+	added, _ := wgtypes.GenerateKey()
+	missing, _ := wgtypes.GenerateKey()
+	hostRoute := func(last byte) *pb.IPNet {
+		return &pb.IPNet{Ip: []byte{10, 7, 0, last}, IpMask: []byte{255, 255, 255, 255}}
+	}
+
+	wgs := WGServer{c: newTestKernel(&wgtypes.Device{
+		Name:  "wg0",
+		Peers: []wgtypes.Peer{{PublicKey: existing, AllowedIPs: []net.IPNet{{IP: net.IP{10, 7, 0, 2}, Mask: net.CIDRMask(32, 32)}}}},
+	})}
+
+	// AddPeer
+	peer, err := wgs.AddPeer("wg0", &pb.PeerConfig{PublicKey: added[:], AllowedIps: []*pb.IPNet{hostRoute(3)}}, "")
+	if err != nil {
+		t.Fatalf("AddPeer: %v", err)
+	}
+	if diff := cmp.Diff([]byte(added[:]), peer.PublicKey); diff != "" {
+		t.Fatalf("unexpected public key (-want +got):\n%s", diff)
+	}
+	_, err = wgs.AddPeer("wg0", &pb.PeerConfig{PublicKey: existing[:]}, "")
+	if !errors.Is(err, os.ErrExist) {
+		t.Fatalf("AddPeer of an existing peer: want os.ErrExist, got %v", err)
+	}
+	_, err = wgs.AddPeer("wg0", &pb.PeerConfig{PublicKey: added[:], Remove: true}, "")
+	if diff := cmp.Diff(invalidField("peer.remove", "must not be set, use RemovePeer"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+
+	// UpdatePeer
+	peer, err = wgs.UpdatePeer("wg0", &pb.PeerConfig{
+		PublicKey:                   existing[:],
+		PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+	}, "")
+	if err != nil {
+		t.Fatalf("UpdatePeer: %v", err)
+	}
+	if diff := cmp.Diff(25*time.Second, peer.PersistentKeepaliveInterval.AsDuration()); diff != "" {
+		t.Fatalf("unexpected keepalive (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(peer.AllowedIps)); diff != "" {
+		t.Fatalf("allowed ips are not kept (-want +got):\n%s", diff)
+	}
+	_, err = wgs.UpdatePeer("wg0", &pb.PeerConfig{PublicKey: missing[:]}, "")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("UpdatePeer of a missing peer: want os.ErrNotExist, got %v", err)
+	}
+
+	// GetPeer and ListPeers
+	peer, err = wgs.Peer("wg0", added[:])
+	if err != nil {
+		t.Fatalf("Peer: %v", err)
+	}
+	if diff := cmp.Diff([]byte{10, 7, 0, 3}, peer.AllowedIps[0].Ip); diff != "" {
+		t.Fatalf("unexpected allowed ip (-want +got):\n%s", diff)
+	}
+	if _, err := wgs.Peer("wg0", missing[:]); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Peer of a missing peer: want os.ErrNotExist, got %v", err)
+	}
+	peers, err := wgs.Peers("wg0")
+	if err != nil {
+		t.Fatalf("Peers: %v", err)
+	}
+	if diff := cmp.Diff(2, len(peers)); diff != "" {
+		t.Fatalf("unexpected number of peers (-want +got):\n%s", diff)
+	}
+
+	// RemovePeer is idempotent
+	for i := 0; i < 2; i++ {
+		if err := wgs.RemovePeer("wg0", added[:], ""); err != nil {
+			t.Fatalf("RemovePeer #%d: %v", i, err)
+		}
+	}
+	if _, err := wgs.Peer("wg0", added[:]); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("removed peer is still present: %v", err)
+	}
+	err = wgs.RemovePeer("wg0", []byte{1}, "")
+	if diff := cmp.Diff(invalidField("public_key", "must be 32 bytes, got 1"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+	if err := wgs.RemovePeer("wg1", added[:], ""); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("RemovePeer on a missing device: want os.ErrNotExist, got %v", err)
+	}
+}
+
+// newTestKernel returns a testClient which keeps the devices in memory and
+// applies configurations the way the kernel does.
+func newTestKernel(devices ...*wgtypes.Device) *testClient {
+	// The kernel serializes netlink requests, so does the test kernel.
+	var mu sync.Mutex
+	byName := make(map[string]*wgtypes.Device, len(devices))
+	for _, d := range devices {
+		byName[d.Name] = d
+	}
+	return &testClient{
+		CloseFunc: func() error { return nil },
+		DeviceFunc: func(name string) (*wgtypes.Device, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			d, ok := byName[name]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return cloneDevice(d), nil
+		},
+		DevicesFunc: func() ([]*wgtypes.Device, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var out []*wgtypes.Device
+			for _, d := range byName {
+				out = append(out, cloneDevice(d))
+			}
+			return out, nil
+		},
+		ConfigureDeviceFunc: func(name string, cfg wgtypes.Config) error {
+			mu.Lock()
+			defer mu.Unlock()
+			d, ok := byName[name]
+			if !ok {
+				return fmt.Errorf("configure %s: %w", name, os.ErrNotExist)
+			}
+			applyTestConfig(d, cfg)
+			return nil
+		},
+	}
+}
+
+// applyTestConfig applies cfg to dev like the kernel does. It is kept apart
+// from applyConfig, so the tests of the server don't check applyConfig
+// against itself.
+func applyTestConfig(dev *wgtypes.Device, cfg wgtypes.Config) {
+	if cfg.PrivateKey != nil {
+		dev.PrivateKey = *cfg.PrivateKey
+		dev.PublicKey = wgtypes.Key{}
+		if *cfg.PrivateKey != (wgtypes.Key{}) {
+			dev.PublicKey = cfg.PrivateKey.PublicKey()
+		}
+	}
+	if cfg.ListenPort != nil {
+		dev.ListenPort = *cfg.ListenPort
+	}
+	if cfg.FirewallMark != nil {
+		dev.FirewallMark = *cfg.FirewallMark
+	}
+	if cfg.ReplacePeers {
+		dev.Peers = nil
+	}
+	for _, pc := range cfg.Peers {
+		if pc.Remove {
+			var kept []wgtypes.Peer
+			for _, p := range dev.Peers {
+				if p.PublicKey != pc.PublicKey {
+					kept = append(kept, p)
+				}
+			}
+			dev.Peers = kept
+			continue
+		}
+		var p *wgtypes.Peer
+		for i := range dev.Peers {
+			if dev.Peers[i].PublicKey == pc.PublicKey {
+				p = &dev.Peers[i]
+			}
+		}
+		if p == nil {
+			if pc.UpdateOnly {
+				continue
+			}
+			dev.Peers = append(dev.Peers, wgtypes.Peer{PublicKey: pc.PublicKey, ProtocolVersion: 1})
+			p = &dev.Peers[len(dev.Peers)-1]
+		}
+		if pc.PresharedKey != nil {
+			p.PresharedKey = *pc.PresharedKey
+		}
+		if pc.Endpoint != nil {
+			p.Endpoint = pc.Endpoint
+		}
+		if pc.PersistentKeepaliveInterval != nil {
+			p.PersistentKeepaliveInterval = *pc.PersistentKeepaliveInterval
+		}
+		if pc.ReplaceAllowedIPs {
+			p.AllowedIPs = nil
+		}
+		// The kernel keeps allowed IPs in a single table, an IP added to a
+		// peer is taken from the peer which had it.
+		added := map[string]bool{}
+		for _, ipn := range pc.AllowedIPs {
+			added[ipn.String()] = true
+		}
+		for i := range dev.Peers {
+			var ipns []net.IPNet
+			for _, ipn := range dev.Peers[i].AllowedIPs {
+				if !added[ipn.String()] {
+					ipns = append(ipns, ipn)
+				}
+			}
+			dev.Peers[i].AllowedIPs = ipns
+		}
+		p.AllowedIPs = append(p.AllowedIPs, pc.AllowedIPs...)
+	}
+}
@@ -0,0 +1,198 @@
+package wgserver
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// secretValue stands for a key which is set in a FieldChange.
+const secretValue = "(secret)"
+
+// PlanConfigureDevice reports what ConfigureDevice would change on a
+// device, without touching it. The configuration is applied to a copy of
+// the current device with the semantics of the kernel.
+func (wgs *WGServer) PlanConfigureDevice(name string, cfg *pb.Config) (*pb.DevicePlan, error) {
+	verr := &ValidationError{}
+	if name == "" {
+		verr.add("name", "must not be empty")
+	}
+	validateConfig(verr, "config", cfg)
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, err
+	}
+	next := cloneDevice(dev)
+	applyConfig(next, pbConfig2wgConfig(cfg))
+	return planDevice(dev, next), nil
+}
+
+// applyConfig applies cfg to dev like the kernel does: peers are matched
+// by public key and an allowed IP belongs to a single peer, so adding it to
+// a peer removes it from any other.
+func applyConfig(dev *wgtypes.Device, cfg wgtypes.Config) {
+	if cfg.PrivateKey != nil {
+		dev.PrivateKey = *cfg.PrivateKey
+		dev.PublicKey = cfg.PrivateKey.PublicKey()
+		if dev.PrivateKey == (wgtypes.Key{}) {
+			dev.PublicKey = wgtypes.Key{}
+		}
+	}
+	if cfg.ListenPort != nil {
+		dev.ListenPort = *cfg.ListenPort
+	}
+	if cfg.FirewallMark != nil {
+		dev.FirewallMark = *cfg.FirewallMark
+	}
+	if cfg.ReplacePeers {
+		dev.Peers = nil
+	}
+	for _, pc := range cfg.Peers {
+		idx := -1
+		for i := range dev.Peers {
+			if dev.Peers[i].PublicKey == pc.PublicKey {
+				idx = i
+			}
+		}
+		switch {
+		case pc.Remove:
+			if idx >= 0 {
+				dev.Peers = append(dev.Peers[:idx], dev.Peers[idx+1:]...)
+			}
+			continue
+		case idx < 0 && pc.UpdateOnly:
+			continue
+		case idx < 0:
+			dev.Peers = append(dev.Peers, wgtypes.Peer{PublicKey: pc.PublicKey, ProtocolVersion: 1})
+			idx = len(dev.Peers) - 1
+		}
+		p := &dev.Peers[idx]
+		if pc.PresharedKey != nil {
+			p.PresharedKey = *pc.PresharedKey
+		}
+		if pc.Endpoint != nil {
+			p.Endpoint = pc.Endpoint
+		}
+		if pc.PersistentKeepaliveInterval != nil {
+			p.PersistentKeepaliveInterval = *pc.PersistentKeepaliveInterval
+		}
+		if pc.ReplaceAllowedIPs {
+			p.AllowedIPs = nil
+		}
+		for _, ipn := range pc.AllowedIPs {
+			for i := range dev.Peers {
+				dev.Peers[i].AllowedIPs = removeIPNet(dev.Peers[i].AllowedIPs, ipn)
+			}
+			p.AllowedIPs = append(p.AllowedIPs, ipn)
+		}
+	}
+}
+
+// removeIPNet returns ipns without the network ipn.
+func removeIPNet(ipns []net.IPNet, ipn net.IPNet) []net.IPNet {
+	out := ipns[:0]
+	for _, n := range ipns {
+		if n.String() != ipn.String() {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// cloneDevice copies dev deeply enough for applyConfig.
+func cloneDevice(dev *wgtypes.Device) *wgtypes.Device {
+	cp := *dev
+	cp.Peers = make([]wgtypes.Peer, len(dev.Peers))
+	for i, p := range dev.Peers {
+		p.AllowedIPs = append([]net.IPNet(nil), p.AllowedIPs...)
+		cp.Peers[i] = p
+	}
+	return &cp
+}
+
+// planDevice describes the changes from dev to next.
+func planDevice(dev, next *wgtypes.Device) *pb.DevicePlan {
+	plan := &pb.DevicePlan{}
+	var changes fieldChanges
+	changes.addSecret("private_key", dev.PrivateKey, next.PrivateKey)
+	changes.add("public_key", keyString(dev.PublicKey), keyString(next.PublicKey))
+	changes.add("listen_port", strconv.Itoa(dev.ListenPort), strconv.Itoa(next.ListenPort))
+	changes.add("firewall_mark", strconv.Itoa(dev.FirewallMark), strconv.Itoa(next.FirewallMark))
+	plan.Changes = changes
+
+	for i := range next.Peers {
+		p := &next.Peers[i]
+		old := findPeer(dev, p.PublicKey)
+		if old == nil {
+			old = &wgtypes.Peer{}
+		}
+		if changes := peerChanges(old, p); len(changes) > 0 {
+			pc := &pb.PeerChange{PublicKey: wgKey2pbKey(&p.PublicKey), Changes: changes}
+			if findPeer(dev, p.PublicKey) == nil {
+				plan.AddedPeers = append(plan.AddedPeers, pc)
+			} else {
+				plan.ModifiedPeers = append(plan.ModifiedPeers, pc)
+			}
+		}
+	}
+	for i := range dev.Peers {
+		if key := dev.Peers[i].PublicKey; findPeer(next, key) == nil {
+			plan.RemovedPeers = append(plan.RemovedPeers, wgKey2pbKey(&key))
+		}
+	}
+	return plan
+}
+
+// peerChanges lists the fields of a peer which differ.
+func peerChanges(p, next *wgtypes.Peer) []*pb.FieldChange {
+	var changes fieldChanges
+	changes.addSecret("preshared_key", p.PresharedKey, next.PresharedKey)
+	changes.add("endpoint", udpAddrString(p.Endpoint), udpAddrString(next.Endpoint))
+	changes.add("persistent_keepalive_interval", durationString(p.PersistentKeepaliveInterval), durationString(next.PersistentKeepaliveInterval))
+	changes.add("allowed_ips", ipNetsString(p.AllowedIPs), ipNetsString(next.AllowedIPs))
+	return changes
+}
+
+// fieldChanges collects the fields whose value changed.
+type fieldChanges []*pb.FieldChange
+
+func (c *fieldChanges) add(field, old, next string) {
+	if old != next {
+		*c = append(*c, &pb.FieldChange{Field: field, OldValue: old, NewValue: next})
+	}
+}
+
+// addSecret records a changed key without revealing it.
+func (c *fieldChanges) addSecret(field string, old, next wgtypes.Key) {
+	if old != next {
+		*c = append(*c, &pb.FieldChange{Field: field, OldValue: secretString(old), NewValue: secretString(next)})
+	}
+}
+
+func secretString(key wgtypes.Key) string {
+	if key == (wgtypes.Key{}) {
+		return ""
+	}
+	return secretValue
+}
+
+func keyString(key wgtypes.Key) string {
+	if key == (wgtypes.Key{}) {
+		return ""
+	}
+	return key.String()
+}
+
+func durationString(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
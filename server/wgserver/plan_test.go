@@ -0,0 +1,319 @@
+package wgserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestPlanConfigureDevice(t *testing.T) {
+	a, _ := wgtypes.GenerateKey()
+	b, _ := wgtypes.GenerateKey()
+	c, _ := wgtypes.GenerateKey()
+	d, _ := wgtypes.GenerateKey()
+	e, _ := wgtypes.GenerateKey()
+	psk, _ := wgtypes.GenerateKey()
+	host := func(last byte) net.IPNet { return net.IPNet{IP: net.IP{10, 7, 0, last}, Mask: net.CIDRMask(32, 32)} }
+	dev := &wgtypes.Device{
+		Name:       "wg0",
+		ListenPort: 51820,
+		Peers: []wgtypes.Peer{
+			{PublicKey: a, PersistentKeepaliveInterval: 25 * time.Second, AllowedIPs: []net.IPNet{host(2)}},
+			{PublicKey: b, AllowedIPs: []net.IPNet{host(3)}},
+			{PublicKey: c, AllowedIPs: []net.IPNet{host(4)}},
+		},
+	}
+	before := cloneDevice(dev)
+	wgs := WGServer{c: &testClient{
+		DeviceFunc: newTestKernel(dev).DeviceFunc,
+		ConfigureDeviceFunc: func(string, wgtypes.Config) error {
+			t.Fatal("the device was configured")
+			return nil
+		},
+	}}
+
+	plan, err := wgs.PlanConfigureDevice("wg0", &pb.Config{
+		ListenPort: proto.Int32(51821),
+		Peers: []*pb.PeerConfig{
+			{PublicKey: a[:], AllowedIps: []*pb.IPNet{{Ip: []byte{10, 7, 0, 3}, IpMask: []byte{255, 255, 255, 255}}}},
+			{
+				PublicKey:                   d[:],
+				PresharedKey:                psk[:],
+				Endpoint:                    &pb.UDPAddr{Ip: []byte{203, 0, 113, 1}, Port: 51820},
+				PersistentKeepaliveInterval: durationpb.New(0),
+				AllowedIps:                  []*pb.IPNet{{Ip: []byte{10, 7, 0, 5}, IpMask: []byte{255, 255, 255, 255}}},
+			},
+			{PublicKey: c[:], Remove: true},
+			{PublicKey: e[:], UpdateOnly: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PlanConfigureDevice: %v", err)
+	}
+	want := &pb.DevicePlan{
+		Changes: []*pb.FieldChange{{Field: "listen_port", OldValue: "51820", NewValue: "51821"}},
+		AddedPeers: []*pb.PeerChange{{
+			PublicKey: d[:],
+			Changes: []*pb.FieldChange{
+				{Field: "preshared_key", NewValue: "(secret)"},
+				{Field: "endpoint", NewValue: "203.0.113.1:51820"},
+				{Field: "allowed_ips", NewValue: "10.7.0.5/32"},
+			},
+		}},
+		RemovedPeers: [][]byte{c[:]},
+		ModifiedPeers: []*pb.PeerChange{
+			{
+				PublicKey: a[:],
+				Changes:   []*pb.FieldChange{{Field: "allowed_ips", OldValue: "10.7.0.2/32", NewValue: "10.7.0.2/32 10.7.0.3/32"}},
+			},
+			{
+				PublicKey: b[:],
+				Changes:   []*pb.FieldChange{{Field: "allowed_ips", OldValue: "10.7.0.3/32"}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, plan, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected plan (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(before, dev); diff != "" {
+		t.Fatalf("the device was modified (-want +got):\n%s", diff)
+	}
+
+	plan, err = wgs.PlanConfigureDevice("wg0", &pb.Config{ReplacePeers: true})
+	if err != nil {
+		t.Fatalf("PlanConfigureDevice: %v", err)
+	}
+	want = &pb.DevicePlan{RemovedPeers: [][]byte{a[:], b[:], c[:]}}
+	if diff := cmp.Diff(want, plan, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected plan (-want +got):\n%s", diff)
+	}
+
+	_, err = wgs.PlanConfigureDevice("wg0", &pb.Config{Peers: []*pb.PeerConfig{{}}})
+	if diff := cmp.Diff(invalidField("config.peers[0].public_key", "is required"), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	a, _ := wgtypes.GenerateKey()
+	b, _ := wgtypes.GenerateKey()
+	c, _ := wgtypes.GenerateKey()
+	privateKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.GenerateKey()
+	host := func(last byte) net.IPNet { return net.IPNet{IP: net.IP{10, 7, 0, last}, Mask: net.CIDRMask(32, 32)} }
+	endpoint := &net.UDPAddr{IP: net.IP{203, 0, 113, 1}, Port: 51820}
+	port, mark, keepalive := 51821, 0x1234, 25*time.Second
+	zero := wgtypes.Key{}
+	device := func() *wgtypes.Device {
+		return &wgtypes.Device{
+			Name:       "wg0",
+			PrivateKey: privateKey,
+			PublicKey:  privateKey.PublicKey(),
+			ListenPort: 51820,
+			Peers: []wgtypes.Peer{
+				{PublicKey: a, AllowedIPs: []net.IPNet{host(2), host(3)}},
+				{PublicKey: b, AllowedIPs: []net.IPNet{host(4)}},
+			},
+		}
+	}
+	tests := []struct {
+		name string
+		cfg  wgtypes.Config
+		want *wgtypes.Device
+	}{
+		{
+			name: "Device",
+			cfg:  wgtypes.Config{ListenPort: &port, FirewallMark: &mark},
+			want: &wgtypes.Device{
+				Name:         "wg0",
+				PrivateKey:   privateKey,
+				PublicKey:    privateKey.PublicKey(),
+				ListenPort:   51821,
+				FirewallMark: 0x1234,
+				Peers: []wgtypes.Peer{
+					{PublicKey: a, AllowedIPs: []net.IPNet{host(2), host(3)}},
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(4)}},
+				},
+			},
+		},
+		{
+			name: "RemovePrivateKey",
+			cfg:  wgtypes.Config{PrivateKey: &zero},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: a, AllowedIPs: []net.IPNet{host(2), host(3)}},
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(4)}},
+				},
+			},
+		},
+		{
+			name: "AddPeer",
+			cfg: wgtypes.Config{Peers: []wgtypes.PeerConfig{{
+				PublicKey:                   c,
+				PresharedKey:                &psk,
+				Endpoint:                    endpoint,
+				PersistentKeepaliveInterval: &keepalive,
+				AllowedIPs:                  []net.IPNet{host(5)},
+			}}},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				PrivateKey: privateKey,
+				PublicKey:  privateKey.PublicKey(),
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: a, AllowedIPs: []net.IPNet{host(2), host(3)}},
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(4)}},
+					{
+						PublicKey:                   c,
+						PresharedKey:                psk,
+						Endpoint:                    endpoint,
+						PersistentKeepaliveInterval: 25 * time.Second,
+						AllowedIPs:                  []net.IPNet{host(5)},
+						ProtocolVersion:             1,
+					},
+				},
+			},
+		},
+		{
+			name: "MoveAllowedIP",
+			cfg:  wgtypes.Config{Peers: []wgtypes.PeerConfig{{PublicKey: b, AllowedIPs: []net.IPNet{host(3)}}}},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				PrivateKey: privateKey,
+				PublicKey:  privateKey.PublicKey(),
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: a, AllowedIPs: []net.IPNet{host(2)}},
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(4), host(3)}},
+				},
+			},
+		},
+		{
+			name: "ReplaceAllowedIPs",
+			cfg: wgtypes.Config{Peers: []wgtypes.PeerConfig{{
+				PublicKey:         a,
+				ReplaceAllowedIPs: true,
+				AllowedIPs:        []net.IPNet{host(6)},
+			}}},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				PrivateKey: privateKey,
+				PublicKey:  privateKey.PublicKey(),
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: a, AllowedIPs: []net.IPNet{host(6)}},
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(4)}},
+				},
+			},
+		},
+		{
+			name: "UpdateOnlyAndRemove",
+			cfg: wgtypes.Config{Peers: []wgtypes.PeerConfig{
+				{PublicKey: c, UpdateOnly: true, AllowedIPs: []net.IPNet{host(2)}},
+				{PublicKey: b, UpdateOnly: true, Endpoint: endpoint},
+				{PublicKey: a, Remove: true},
+				{PublicKey: c, Remove: true},
+			}},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				PrivateKey: privateKey,
+				PublicKey:  privateKey.PublicKey(),
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: b, Endpoint: endpoint, AllowedIPs: []net.IPNet{host(4)}},
+				},
+			},
+		},
+		{
+			name: "ReplacePeers",
+			cfg: wgtypes.Config{ReplacePeers: true, Peers: []wgtypes.PeerConfig{
+				{PublicKey: b, AllowedIPs: []net.IPNet{host(2)}},
+			}},
+			want: &wgtypes.Device{
+				Name:       "wg0",
+				PrivateKey: privateKey,
+				PublicKey:  privateKey.PublicKey(),
+				ListenPort: 51820,
+				Peers: []wgtypes.Peer{
+					{PublicKey: b, AllowedIPs: []net.IPNet{host(2)}, ProtocolVersion: 1},
+				},
+			},
+		},
+	}
+	// The test kernel must agree with the expectations too, the tests of
+	// the server rely on it.
+	appliers := map[string]func(*wgtypes.Device, wgtypes.Config){
+		"applyConfig":     applyConfig,
+		"applyTestConfig": applyTestConfig,
+	}
+	for _, tc := range tests {
+		for name, apply := range appliers {
+			t.Run(tc.name+"/"+name, func(t *testing.T) {
+				dev := device()
+				apply(dev, tc.cfg)
+				if diff := cmp.Diff(tc.want, dev, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("unexpected device (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestPlanDevice(t *testing.T) {
+	a, _ := wgtypes.GenerateKey()
+	b, _ := wgtypes.GenerateKey()
+	oldKey, _ := wgtypes.GeneratePrivateKey()
+	newKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.GenerateKey()
+	dev := &wgtypes.Device{
+		PrivateKey:   oldKey,
+		PublicKey:    oldKey.PublicKey(),
+		ListenPort:   51820,
+		FirewallMark: 1,
+		Peers: []wgtypes.Peer{
+			{PublicKey: a, PersistentKeepaliveInterval: 25 * time.Second},
+			{PublicKey: b},
+		},
+	}
+	next := &wgtypes.Device{
+		PrivateKey: newKey,
+		PublicKey:  newKey.PublicKey(),
+		ListenPort: 51820,
+		Peers: []wgtypes.Peer{
+			{PublicKey: a, PresharedKey: psk, Endpoint: &net.UDPAddr{IP: net.IP{203, 0, 113, 1}, Port: 51820}},
+			{PublicKey: b},
+		},
+	}
+	want := &pb.DevicePlan{
+		Changes: []*pb.FieldChange{
+			{Field: "private_key", OldValue: "(secret)", NewValue: "(secret)"},
+			{Field: "public_key", OldValue: oldKey.PublicKey().String(), NewValue: newKey.PublicKey().String()},
+			{Field: "firewall_mark", OldValue: "1", NewValue: "0"},
+		},
+		ModifiedPeers: []*pb.PeerChange{{
+			PublicKey: a[:],
+			Changes: []*pb.FieldChange{
+				{Field: "preshared_key", NewValue: "(secret)"},
+				{Field: "endpoint", NewValue: "203.0.113.1:51820"},
+				{Field: "persistent_keepalive_interval", OldValue: "25s"},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, planDevice(dev, next), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected plan (-want +got):\n%s", diff)
+	}
+	if plan := planDevice(dev, dev); !proto.Equal(plan, &pb.DevicePlan{}) {
+		t.Fatalf("plan of an unchanged device: %v", plan)
+	}
+}
@@ -0,0 +1,55 @@
+package wgserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// revision hashes the configuration of a device: its private key, listen
+// port, firewall mark and its peers with their preshared keys, keepalive
+// intervals and allowed IPs. Endpoints roam and statistics change with
+// every packet, so they are left out.
+func revision(dev *wgtypes.Device) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%x %d %d\n", dev.PrivateKey[:], dev.ListenPort, dev.FirewallMark)
+	peers := make([]*wgtypes.Peer, 0, len(dev.Peers))
+	for i := range dev.Peers {
+		peers = append(peers, &dev.Peers[i])
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].PublicKey.String() < peers[j].PublicKey.String() })
+	for _, p := range peers {
+		fmt.Fprintf(h, "%x %x %d %s\n", p.PublicKey[:], p.PresharedKey[:], p.PersistentKeepaliveInterval, ipNetsString(p.AllowedIPs))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// checkRevision fails with Aborted if an expected revision is given and
+// dev has another one.
+func checkRevision(dev *wgtypes.Device, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	if got := revision(dev); got != expected {
+		return status.Errorf(codes.Aborted, "device %s has changed: revision %s, expected %s", dev.Name, got, expected)
+	}
+	return nil
+}
+
+// checkDeviceRevision reads a device and checks its revision, if expected
+// is given.
+func (wgs *WGServer) checkDeviceRevision(name, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return err
+	}
+	return checkRevision(dev, expected)
+}
@@ -0,0 +1,84 @@
+package wgserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRevision(t *testing.T) {
+	a, _ := wgtypes.GenerateKey()
+	b, _ := wgtypes.GenerateKey()
+	dev := &wgtypes.Device{
+		Name:       "wg0",
+		ListenPort: 51820,
+		Peers: []wgtypes.Peer{
+			{PublicKey: a, AllowedIPs: []net.IPNet{{IP: net.IP{10, 7, 0, 2}, Mask: net.CIDRMask(32, 32)}}},
+			{PublicKey: b},
+		},
+	}
+	rev := revision(dev)
+
+	same := cloneDevice(dev)
+	same.Peers[0], same.Peers[1] = same.Peers[1], same.Peers[0]
+	same.Peers[0].Endpoint = &net.UDPAddr{IP: net.IP{203, 0, 113, 1}, Port: 51820}
+	same.Peers[0].LastHandshakeTime = time.Now()
+	same.Peers[1].ReceiveBytes = 100
+	if got := revision(same); got != rev {
+		t.Errorf("revision changed with peer order, endpoint or statistics: %s, was %s", got, rev)
+	}
+
+	for name, change := range map[string]func(*wgtypes.Device){
+		"ListenPort": func(d *wgtypes.Device) { d.ListenPort = 51821 },
+		"Keepalive":  func(d *wgtypes.Device) { d.Peers[1].PersistentKeepaliveInterval = 25 * time.Second },
+		"AllowedIPs": func(d *wgtypes.Device) { d.Peers[0].AllowedIPs = nil },
+		"Peers":      func(d *wgtypes.Device) { d.Peers = d.Peers[:1] },
+	} {
+		changed := cloneDevice(dev)
+		change(changed)
+		if got := revision(changed); got == rev {
+			t.Errorf("%s: revision did not change", name)
+		}
+	}
+}
+
+func TestExpectedRevision(t *testing.T) {
+	dev := &wgtypes.Device{Name: "wg0", ListenPort: 51820}
+	wgs := WGServer{c: newTestKernel(dev)}
+	got, err := wgs.Device("wg0")
+	if err != nil {
+		t.Fatalf("Device: %v", err)
+	}
+	rev := got.Revision
+
+	cfg := &pb.Config{ListenPort: proto.Int32(51821)}
+	if err := wgs.ConfigureDevice("wg0", cfg, rev); err != nil {
+		t.Fatalf("ConfigureDevice with the current revision: %v", err)
+	}
+	// The revision is stale now.
+	err = wgs.ConfigureDevice("wg0", &pb.Config{ListenPort: proto.Int32(51822)}, rev)
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("ConfigureDevice with a stale revision: %v", err)
+	}
+	peer, _ := wgtypes.GenerateKey()
+	if _, err := wgs.AddPeer("wg0", &pb.PeerConfig{PublicKey: peer[:]}, rev); status.Code(err) != codes.Aborted {
+		t.Fatalf("AddPeer with a stale revision: %v", err)
+	}
+	if err := wgs.RemovePeer("wg0", peer[:], rev); status.Code(err) != codes.Aborted {
+		t.Fatalf("RemovePeer with a stale revision: %v", err)
+	}
+	err = wgs.BatchConfigure([]*pb.ConfigureDeviceRequest{{Name: "wg0", Config: cfg, ExpectedRevision: rev}})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("BatchConfigure with a stale revision: %v", err)
+	}
+	if dev.ListenPort != 51821 || len(dev.Peers) != 0 {
+		t.Fatalf("the device was changed with a stale revision: %+v", dev)
+	}
+}
@@ -0,0 +1,269 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Reconcile brings every device of the store back to its desired state and
+// reports what had to be fixed. A device which can't be reconciled does not
+// stop the others, its error is part of the report.
+func (wgs *WGServer) Reconcile() ([]*pb.DeviceReconciliation, error) {
+	if wgs.s == nil {
+		return nil, status.Error(codes.FailedPrecondition, "desired state is not persisted")
+	}
+	devices, err := wgs.s.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load desired state: %w", err)
+	}
+	report := make([]*pb.DeviceReconciliation, 0, len(devices))
+	for _, d := range devices {
+		fixes, err := wgs.reconcileDevice(d)
+		r := &pb.DeviceReconciliation{Name: d.GetName(), Fixes: fixes}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		report = append(report, r)
+	}
+	return report, nil
+}
+
+func (wgs *WGServer) reconcileDevice(d *pb.DesiredDevice) ([]string, error) {
+	name := d.GetName()
+	verr := &ValidationError{}
+	validateLinkName(verr, "name", name)
+	validateConfig(verr, "config", d.GetConfig())
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+
+	defer wgs.lockDevices(name)()
+	var fixes []string
+	dev, err := wgs.c.Device(name)
+	if errors.Is(err, os.ErrNotExist) && wgs.l != nil {
+		if err := wgs.l.CreateLink(name); err != nil {
+			return nil, err
+		}
+		fixes = append(fixes, "created the device")
+		dev, err = wgs.c.Device(name)
+	}
+	if err != nil {
+		return fixes, err
+	}
+
+	cfg, cfgFixes := configFixes(dev, pbConfig2wgConfig(d.GetConfig()))
+	if len(cfgFixes) > 0 {
+		if err := wgs.c.ConfigureDevice(name, cfg); err != nil {
+			return fixes, err
+		}
+		fixes = append(fixes, cfgFixes...)
+	}
+
+	if d.GetMtu() == 0 || wgs.l == nil {
+		return fixes, nil
+	}
+	link, err := wgs.l.Link(name)
+	if errors.Is(err, ErrUnsupported) {
+		return fixes, nil
+	}
+	if err != nil {
+		return fixes, err
+	}
+	if link.MTU != int(d.GetMtu()) {
+		if err := wgs.l.SetMTU(name, int(d.GetMtu())); err != nil {
+			return fixes, err
+		}
+		fixes = append(fixes, fmt.Sprintf("mtu %d, was %d", d.GetMtu(), link.MTU))
+	}
+	want := make([]net.IPNet, 0, len(d.GetAddresses()))
+	for _, a := range d.GetAddresses() {
+		want = append(want, *pb2IPNet(a))
+	}
+	for _, a := range want {
+		if !containsAddress(link.Addresses, a) {
+			if err := wgs.l.AddAddress(name, a); err != nil {
+				return fixes, err
+			}
+			fixes = append(fixes, fmt.Sprintf("added address %s", a.String()))
+		}
+	}
+	for _, a := range link.Addresses {
+		if !containsAddress(want, a) {
+			if err := wgs.l.DeleteAddress(name, a); err != nil {
+				return fixes, err
+			}
+			fixes = append(fixes, fmt.Sprintf("removed address %s", a.String()))
+		}
+	}
+	if up := link.Flags&iffUp != 0; up != d.GetUp() {
+		if err := wgs.l.SetUp(name, d.GetUp()); err != nil {
+			return fixes, err
+		}
+		fixes = append(fixes, fmt.Sprintf("link up %t, was %t", d.GetUp(), up))
+	}
+	return fixes, nil
+}
+
+// configFixes returns the configuration which changes dev into want and
+// describes every change. Endpoints of existing peers are left alone since
+// peers roam.
+func configFixes(dev *wgtypes.Device, want wgtypes.Config) (wgtypes.Config, []string) {
+	var cfg wgtypes.Config
+	var fixes []string
+	if want.PrivateKey != nil && *want.PrivateKey != dev.PrivateKey {
+		cfg.PrivateKey = want.PrivateKey
+		fixes = append(fixes, "restored the private key")
+	}
+	if want.ListenPort != nil && *want.ListenPort != dev.ListenPort {
+		cfg.ListenPort = want.ListenPort
+		fixes = append(fixes, fmt.Sprintf("listen port %d, was %d", *want.ListenPort, dev.ListenPort))
+	}
+	if want.FirewallMark != nil && *want.FirewallMark != dev.FirewallMark {
+		cfg.FirewallMark = want.FirewallMark
+		fixes = append(fixes, fmt.Sprintf("firewall mark %d, was %d", *want.FirewallMark, dev.FirewallMark))
+	}
+
+	wanted := make(map[wgtypes.Key]bool, len(want.Peers))
+	for _, pc := range want.Peers {
+		wanted[pc.PublicKey] = true
+		p := findPeer(dev, pc.PublicKey)
+		if p == nil {
+			cfg.Peers = append(cfg.Peers, pc)
+			fixes = append(fixes, fmt.Sprintf("added peer %s", pc.PublicKey))
+			continue
+		}
+		if diffs := peerDiffs(p, pc); len(diffs) > 0 {
+			pc.Endpoint = nil
+			pc.UpdateOnly = true
+			pc.ReplaceAllowedIPs = true
+			cfg.Peers = append(cfg.Peers, pc)
+			fixes = append(fixes, fmt.Sprintf("updated peer %s: %s", pc.PublicKey, strings.Join(diffs, ", ")))
+		}
+	}
+	for i := range dev.Peers {
+		if key := dev.Peers[i].PublicKey; !wanted[key] {
+			cfg.Peers = append(cfg.Peers, wgtypes.PeerConfig{PublicKey: key, Remove: true})
+			fixes = append(fixes, fmt.Sprintf("removed peer %s", key))
+		}
+	}
+	return cfg, fixes
+}
+
+// peerDiffs describes how the peer p differs from its configuration pc.
+func peerDiffs(p *wgtypes.Peer, pc wgtypes.PeerConfig) []string {
+	var diffs []string
+	var psk wgtypes.Key
+	if pc.PresharedKey != nil {
+		psk = *pc.PresharedKey
+	}
+	if psk != p.PresharedKey {
+		diffs = append(diffs, "preshared key")
+	}
+	keepalive := p.PersistentKeepaliveInterval
+	if pc.PersistentKeepaliveInterval != nil {
+		keepalive = *pc.PersistentKeepaliveInterval
+	}
+	if keepalive != p.PersistentKeepaliveInterval {
+		diffs = append(diffs, fmt.Sprintf("persistent keepalive %s, was %s", keepalive, p.PersistentKeepaliveInterval))
+	}
+	if want, got := ipNetsString(pc.AllowedIPs), ipNetsString(p.AllowedIPs); want != got {
+		diffs = append(diffs, fmt.Sprintf("allowed ips [%s], was [%s]", want, got))
+	}
+	return diffs
+}
+
+// ipNetsString returns the sorted networks separated by spaces.
+func ipNetsString(ipns []net.IPNet) string {
+	s := make([]string, 0, len(ipns))
+	for _, ipn := range ipns {
+		s = append(s, ipn.String())
+	}
+	sort.Strings(s)
+	return strings.Join(s, " ")
+}
+
+// record saves the current state of a device as its desired state. It is
+// called after every successful change of a device.
+func (wgs *WGServer) record(name string) error {
+	if wgs.s == nil {
+		return nil
+	}
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return fmt.Errorf("record desired state of %s: %w", name, err)
+	}
+	desired := &pb.DesiredDevice{Name: name, Config: desiredConfig(dev)}
+	if wgs.l != nil {
+		link, err := wgs.l.Link(name)
+		switch {
+		case err == nil:
+			desired.Mtu = int32(link.MTU)
+			desired.Up = link.Flags&iffUp != 0
+			for _, a := range link.Addresses {
+				desired.Addresses = append(desired.Addresses, &pb.IPNet{Ip: a.IP, IpMask: a.Mask})
+			}
+		case !errors.Is(err, ErrUnsupported):
+			return fmt.Errorf("record desired state of %s: %w", name, err)
+		}
+	}
+	if err := wgs.s.Save(desired); err != nil {
+		return fmt.Errorf("save desired state of %s: %w", name, err)
+	}
+	return nil
+}
+
+// forget removes a deleted device from the desired state.
+func (wgs *WGServer) forget(name string) error {
+	if wgs.s == nil {
+		return nil
+	}
+	if err := wgs.s.Delete(name); err != nil {
+		return fmt.Errorf("delete desired state of %s: %w", name, err)
+	}
+	return nil
+}
+
+// desiredConfig returns the complete configuration of dev.
+func desiredConfig(dev *wgtypes.Device) *pb.Config {
+	cfg := &pb.Config{
+		ListenPort:   proto.Int32(int32(dev.ListenPort)),
+		FirewallMark: proto.Int32(int32(dev.FirewallMark)),
+		ReplacePeers: true,
+	}
+	if dev.PrivateKey != (wgtypes.Key{}) {
+		cfg.PrivateKey = wgKey2pbKey(&dev.PrivateKey)
+	}
+	for i := range dev.Peers {
+		p := &dev.Peers[i]
+		pc := &pb.PeerConfig{
+			PublicKey:                   wgKey2pbKey(&p.PublicKey),
+			Endpoint:                    udpAddr2Pb(p.Endpoint),
+			PersistentKeepaliveInterval: durationpb.New(p.PersistentKeepaliveInterval),
+			ReplaceAllowedIps:           true,
+		}
+		if p.PresharedKey != (wgtypes.Key{}) {
+			pc.PresharedKey = wgKey2pbKey(&p.PresharedKey)
+		}
+		for _, ipn := range p.AllowedIPs {
+			ip := ipn.IP
+			if len(ipn.Mask) == net.IPv4len {
+				ip = ip.To4()
+			}
+			pc.AllowedIps = append(pc.AllowedIps, &pb.IPNet{Ip: ip, IpMask: ipn.Mask})
+		}
+		cfg.Peers = append(cfg.Peers, pc)
+	}
+	return cfg
+}
@@ -0,0 +1,147 @@
+package wgserver
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTestStore(t *testing.T) *FileStore {
+	t.Helper()
+	s, err := NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	return s
+}
+
+func TestReconcile(t *testing.T) {
+	peer, _ := wgtypes.GenerateKey()
+	stray, _ := wgtypes.GenerateKey()
+	dev := &wgtypes.Device{Name: "wg0", ListenPort: 51820}
+	wgs := WGServer{c: newTestKernel(dev), s: newTestStore(t)}
+
+	_, err := wgs.AddPeer("wg0", &pb.PeerConfig{
+		PublicKey:                   peer[:],
+		PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+		AllowedIps:                  []*pb.IPNet{{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}},
+	}, "")
+	if err != nil {
+		t.Fatalf("AddPeer: %v", err)
+	}
+
+	reconcile := func(want ...string) {
+		t.Helper()
+		got, err := wgs.Reconcile()
+		if err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		if diff := cmp.Diff([]*pb.DeviceReconciliation{{Name: "wg0", Fixes: want}}, got, protocmp.Transform()); diff != "" {
+			t.Fatalf("unexpected report (-want +got):\n%s", diff)
+		}
+	}
+
+	// The kernel lost the configuration.
+	dev.ListenPort = 40000
+	dev.Peers = []wgtypes.Peer{{PublicKey: stray}}
+	reconcile(
+		"listen port 51820, was 40000",
+		fmt.Sprintf("added peer %s", peer),
+		fmt.Sprintf("removed peer %s", stray),
+	)
+	reconcile()
+
+	dev.Peers[0].AllowedIPs = nil
+	dev.Peers[0].Endpoint = &net.UDPAddr{IP: net.IP{192, 0, 2, 1}, Port: 51820}
+	reconcile(fmt.Sprintf("updated peer %s: allowed ips [10.7.0.2/32], was []", peer))
+	if diff := cmp.Diff("192.0.2.1:51820", dev.Peers[0].Endpoint.String()); diff != "" {
+		t.Fatalf("roamed endpoint was reset (-want +got):\n%s", diff)
+	}
+
+	if _, err := (&WGServer{c: newTestKernel(dev)}).Reconcile(); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Reconcile without a store: want FailedPrecondition, got %v", err)
+	}
+}
+
+func TestReconcileLink(t *testing.T) {
+	var dev *wgtypes.Device
+	link := &Link{MTU: 1500}
+	wgs := WGServer{
+		c: &testClient{
+			DeviceFunc: func(name string) (*wgtypes.Device, error) {
+				if dev == nil {
+					return nil, os.ErrNotExist
+				}
+				return dev, nil
+			},
+			ConfigureDeviceFunc: func(name string, cfg wgtypes.Config) error {
+				applyTestConfig(dev, cfg)
+				return nil
+			},
+		},
+		l: &testLinkManager{
+			CreateLinkFunc: func(name string) error {
+				dev = &wgtypes.Device{Name: name}
+				return nil
+			},
+			LinkFunc: func(name string) (*Link, error) {
+				cp := *link
+				return &cp, nil
+			},
+			SetMTUFunc: func(name string, mtu int) error {
+				link.MTU = mtu
+				return nil
+			},
+			AddAddressFunc: func(name string, addr net.IPNet) error {
+				link.Addresses = append(link.Addresses, addr)
+				return nil
+			},
+			SetUpFunc: func(name string, up bool) error {
+				link.Flags |= iffUp
+				return nil
+			},
+		},
+		s: newTestStore(t),
+	}
+	err := wgs.s.Save(&pb.DesiredDevice{
+		Name:      "wg1",
+		Config:    &pb.Config{ListenPort: proto.Int32(51821)},
+		Mtu:       1420,
+		Up:        true,
+		Addresses: []*pb.IPNet{{Ip: []byte{10, 8, 0, 1}, IpMask: []byte{255, 255, 255, 0}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := wgs.Reconcile()
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	want := []*pb.DeviceReconciliation{{
+		Name: "wg1",
+		Fixes: []string{
+			"created the device",
+			"listen port 51821, was 0",
+			"mtu 1420, was 1500",
+			"added address 10.8.0.1/24",
+			"link up true, was false",
+		},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected report (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,187 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Store persists the desired state of the devices managed by WGServer.
+type Store interface {
+	// Load returns the desired state of every managed device.
+	Load() ([]*pb.DesiredDevice, error)
+	// Save records the desired state of a device.
+	Save(*pb.DesiredDevice) error
+	// Delete forgets a device. Deleting an unknown device succeeds.
+	Delete(name string) error
+	// LoadPools returns the address pools and leases of every device.
+	LoadPools() ([]*pb.AddressPools, error)
+	// SavePools records the address pools and leases of a device. Pools
+	// without prefixes and leases are deleted.
+	SavePools(*pb.AddressPools) error
+}
+
+// FileStore is a Store keeping the desired state in a JSON file.
+//
+// The file holds private keys and is only readable by its owner.
+type FileStore struct {
+	path string
+
+	mu      sync.Mutex
+	devices map[string]*pb.DesiredDevice
+	pools   map[string]*pb.AddressPools
+}
+
+// NewFileStore opens the state file at path. A missing file is an empty state.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path:    path,
+		devices: map[string]*pb.DesiredDevice{},
+		pools:   map[string]*pb.AddressPools{},
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &pb.DesiredState{}
+	if err := protojson.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	for _, d := range state.GetDevices() {
+		s.devices[d.GetName()] = d
+	}
+	for _, p := range state.GetPools() {
+		s.pools[p.GetName()] = p
+	}
+	return s, nil
+}
+
+// Load implements Store.
+func (s *FileStore) Load() ([]*pb.DesiredDevice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted(), nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(d *pb.DesiredDevice) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.devices[d.GetName()]
+	s.devices[d.GetName()] = proto.Clone(d).(*pb.DesiredDevice)
+	if err := s.write(); err != nil {
+		if ok {
+			s.devices[d.GetName()] = prev
+		} else {
+			delete(s.devices, d.GetName())
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.devices[name]
+	if !ok {
+		return nil
+	}
+	delete(s.devices, name)
+	if err := s.write(); err != nil {
+		s.devices[name] = prev
+		return err
+	}
+	return nil
+}
+
+// LoadPools implements Store.
+func (s *FileStore) LoadPools() ([]*pb.AddressPools, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedPools(), nil
+}
+
+// SavePools implements Store.
+func (s *FileStore) SavePools(p *pb.AddressPools) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.pools[p.GetName()]
+	if len(p.GetPrefixes()) == 0 && len(p.GetLeases()) == 0 {
+		if !ok {
+			return nil
+		}
+		delete(s.pools, p.GetName())
+	} else {
+		s.pools[p.GetName()] = proto.Clone(p).(*pb.AddressPools)
+	}
+	if err := s.write(); err != nil {
+		if ok {
+			s.pools[p.GetName()] = prev
+		} else {
+			delete(s.pools, p.GetName())
+		}
+		return err
+	}
+	return nil
+}
+
+// sorted returns copies of the devices ordered by name.
+func (s *FileStore) sorted() []*pb.DesiredDevice {
+	devices := make([]*pb.DesiredDevice, 0, len(s.devices))
+	for _, d := range s.devices {
+		devices = append(devices, proto.Clone(d).(*pb.DesiredDevice))
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].GetName() < devices[j].GetName() })
+	return devices
+}
+
+// sortedPools returns copies of the pools ordered by device name.
+func (s *FileStore) sortedPools() []*pb.AddressPools {
+	pools := make([]*pb.AddressPools, 0, len(s.pools))
+	for _, p := range s.pools {
+		pools = append(pools, proto.Clone(p).(*pb.AddressPools))
+	}
+	sort.Slice(pools, func(i, j int) bool { return pools[i].GetName() < pools[j].GetName() })
+	return pools
+}
+
+// write replaces the state file atomically.
+func (s *FileStore) write() error {
+	b, err := protojson.MarshalOptions{Multiline: true}.Marshal(&pb.DesiredState{
+		Devices: s.sorted(),
+		Pools:   s.sortedPools(),
+	})
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
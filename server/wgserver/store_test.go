@@ -0,0 +1,71 @@
+package wgserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore of a missing file: %v", err)
+	}
+
+	wg0 := &pb.DesiredDevice{Name: "wg0", Mtu: 1420, Config: &pb.Config{PrivateKey: make([]byte, 32)}}
+	wg1 := &pb.DesiredDevice{Name: "wg1", Up: true}
+	for _, d := range []*pb.DesiredDevice{wg1, wg0} {
+		if err := s.Save(d); err != nil {
+			t.Fatalf("Save(%s): %v", d.Name, err)
+		}
+	}
+	if err := s.Delete("wg2"); err != nil {
+		t.Fatalf("Delete of an unknown device: %v", err)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(os.FileMode(0o600), fi.Mode().Perm()); diff != "" {
+		t.Fatalf("unexpected file mode (-want +got):\n%s", diff)
+	}
+
+	// A new store reads the devices back.
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	got, err := s.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if diff := cmp.Diff([]*pb.DesiredDevice{wg0, wg1}, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected devices (-want +got):\n%s", diff)
+	}
+
+	if err := s.Delete("wg0"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	got, _ = s.Load()
+	if diff := cmp.Diff([]*pb.DesiredDevice{wg1}, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected devices after Delete (-want +got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileStore(path); err == nil {
+		t.Fatal("NewFileStore of a corrupt file: want an error")
+	}
+}
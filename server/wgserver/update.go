@@ -0,0 +1,158 @@
+package wgserver
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// UpdateDevice applies updates to the current state of a WireGuard device
+// and returns the device. The device is read, changed and configured under
+// its lock, so a concurrent change of the device is not lost.
+//
+// An error matching os.ErrNotExist is returned if the device or a peer
+// whose allowed IPs are updated does not exist, nothing is changed then.
+// The device revision is checked like by ConfigureDevice.
+func (wgs *WGServer) UpdateDevice(name string, updates []*pb.DeviceUpdate, revision string) (*pb.Device, error) {
+	verr := &ValidationError{}
+	if name == "" {
+		verr.add("name", "must not be empty")
+	}
+	for i, u := range updates {
+		validateDeviceUpdate(verr, fmt.Sprintf("updates[%d]", i), u)
+	}
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+
+	defer wgs.lockDevices(name)()
+	dev, err := wgs.c.Device(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(dev, revision); err != nil {
+		return nil, err
+	}
+	next := cloneDevice(dev)
+	for _, u := range updates {
+		if err := applyUpdate(next, u); err != nil {
+			return nil, err
+		}
+	}
+	cfg, removed := updateConfig(dev, next)
+	if len(cfg.Peers) > 0 {
+		if err := wgs.c.ConfigureDevice(name, cfg); err != nil {
+			return nil, err
+		}
+		for _, key := range removed {
+			if err := wgs.releaseLeases(name, key); err != nil {
+				return nil, err
+			}
+		}
+		if err := wgs.record(name); err != nil {
+			return nil, err
+		}
+	}
+	return wgs.Device(name)
+}
+
+// applyUpdate changes dev like the kernel would: an allowed IP added to a
+// peer is taken away from every other peer.
+func applyUpdate(dev *wgtypes.Device, u *pb.DeviceUpdate) error {
+	switch x := u.GetUpdate().(type) {
+	case *pb.DeviceUpdate_AddAllowedIps:
+		p, err := updatedPeer(dev, x.AddAllowedIps.GetPublicKey())
+		if err != nil {
+			return err
+		}
+		for _, a := range x.AddAllowedIps.GetAllowedIps() {
+			ipn := *pb2IPNet(a)
+			for i := range dev.Peers {
+				if &dev.Peers[i] != p {
+					dev.Peers[i].AllowedIPs = removeIPNet(dev.Peers[i].AllowedIPs, ipn)
+				}
+			}
+			if !containsAddress(p.AllowedIPs, ipn) {
+				p.AllowedIPs = append(p.AllowedIPs, ipn)
+			}
+		}
+	case *pb.DeviceUpdate_RemoveAllowedIps:
+		p, err := updatedPeer(dev, x.RemoveAllowedIps.GetPublicKey())
+		if err != nil {
+			return err
+		}
+		for _, a := range x.RemoveAllowedIps.GetAllowedIps() {
+			p.AllowedIPs = removeIPNet(p.AllowedIPs, *pb2IPNet(a))
+		}
+	case *pb.DeviceUpdate_RemovePeer:
+		key := wgtypes.Key(x.RemovePeer)
+		peers := dev.Peers[:0]
+		for _, p := range dev.Peers {
+			if p.PublicKey != key {
+				peers = append(peers, p)
+			}
+		}
+		dev.Peers = peers
+	}
+	return nil
+}
+
+// updatedPeer returns the peer of dev an update refers to.
+func updatedPeer(dev *wgtypes.Device, publicKey []byte) (*wgtypes.Peer, error) {
+	key := wgtypes.Key(publicKey)
+	p := findPeer(dev, key)
+	if p == nil {
+		return nil, fmt.Errorf("peer %s on %s: %w", key, dev.Name, os.ErrNotExist)
+	}
+	return p, nil
+}
+
+// updateConfig returns the configuration which changes dev into next and
+// the keys of the removed peers. Only peers and their allowed IPs differ
+// between dev and next.
+func updateConfig(dev, next *wgtypes.Device) (wgtypes.Config, []wgtypes.Key) {
+	var cfg wgtypes.Config
+	var removed []wgtypes.Key
+	for i := range dev.Peers {
+		p := &dev.Peers[i]
+		np := findPeer(next, p.PublicKey)
+		switch {
+		case np == nil:
+			cfg.Peers = append(cfg.Peers, wgtypes.PeerConfig{PublicKey: p.PublicKey, Remove: true})
+			removed = append(removed, p.PublicKey)
+		case ipNetsString(np.AllowedIPs) != ipNetsString(p.AllowedIPs):
+			cfg.Peers = append(cfg.Peers, wgtypes.PeerConfig{
+				PublicKey:         p.PublicKey,
+				UpdateOnly:        true,
+				ReplaceAllowedIPs: true,
+				AllowedIPs:        append([]net.IPNet{}, np.AllowedIPs...),
+			})
+		}
+	}
+	return cfg, removed
+}
+
+// validateDeviceUpdate checks a single update of UpdateDevice.
+func validateDeviceUpdate(verr *ValidationError, path string, u *pb.DeviceUpdate) {
+	switch x := u.GetUpdate().(type) {
+	case *pb.DeviceUpdate_AddAllowedIps:
+		validatePeerAllowedIPs(verr, path+".add_allowed_ips", x.AddAllowedIps)
+	case *pb.DeviceUpdate_RemoveAllowedIps:
+		validatePeerAllowedIPs(verr, path+".remove_allowed_ips", x.RemoveAllowedIps)
+	case *pb.DeviceUpdate_RemovePeer:
+		validateKey(verr, path+".remove_peer", x.RemovePeer, true)
+	default:
+		verr.add(path, "must set an update")
+	}
+}
+
+func validatePeerAllowedIPs(verr *ValidationError, path string, p *pb.PeerAllowedIPs) {
+	validateKey(verr, path+".public_key", p.GetPublicKey(), true)
+	for i, ipn := range p.GetAllowedIps() {
+		validateIPNet(verr, fmt.Sprintf("%s.allowed_ips[%d]", path, i), ipn)
+	}
+}
@@ -0,0 +1,129 @@
+package wgserver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"testing"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+func TestUpdateDevice(t *testing.T) {
+	a, _ := wgtypes.GenerateKey()
+	b, _ := wgtypes.GenerateKey()
+	c, _ := wgtypes.GenerateKey()
+	host := func(last byte) net.IPNet { return net.IPNet{IP: net.IP{10, 7, 0, last}, Mask: net.CIDRMask(32, 32)} }
+	dev := &wgtypes.Device{
+		Name: "wg0",
+		Peers: []wgtypes.Peer{
+			{PublicKey: a, AllowedIPs: []net.IPNet{host(2)}},
+			{PublicKey: b, AllowedIPs: []net.IPNet{host(3), host(4)}},
+			{PublicKey: c},
+		},
+	}
+	wgs := WGServer{c: newTestKernel(dev)}
+
+	got, err := wgs.UpdateDevice("wg0", []*pb.DeviceUpdate{
+		{Update: &pb.DeviceUpdate_AddAllowedIps{AddAllowedIps: &pb.PeerAllowedIPs{
+			PublicKey:  a[:],
+			AllowedIps: []*pb.IPNet{mustIPNet(t, "10.7.0.2/32"), mustIPNet(t, "10.7.0.4/32"), mustIPNet(t, "10.7.1.0/24")},
+		}}},
+		{Update: &pb.DeviceUpdate_RemoveAllowedIps{RemoveAllowedIps: &pb.PeerAllowedIPs{
+			PublicKey:  a[:],
+			AllowedIps: []*pb.IPNet{mustIPNet(t, "10.7.1.0/24"), mustIPNet(t, "10.7.2.0/24")},
+		}}},
+		{Update: &pb.DeviceUpdate_RemovePeer{RemovePeer: c[:]}},
+	}, "")
+	if err != nil {
+		t.Fatalf("UpdateDevice: %v", err)
+	}
+	if got.GetRevision() != revision(dev) {
+		t.Errorf("UpdateDevice returned revision %s, the device has %s", got.GetRevision(), revision(dev))
+	}
+	want := map[wgtypes.Key]string{
+		a: "10.7.0.2/32 10.7.0.4/32",
+		// The kernel moves 10.7.0.4/32 from b to a.
+		b: "10.7.0.3/32",
+	}
+	peers := map[wgtypes.Key]string{}
+	for _, p := range dev.Peers {
+		peers[p.PublicKey] = ipNetsString(p.AllowedIPs)
+	}
+	if diff := cmp.Diff(want, peers); diff != "" {
+		t.Fatalf("unexpected peers (-want +got):\n%s", diff)
+	}
+
+	// Nothing is changed if an update fails.
+	before := revision(dev)
+	missing, _ := wgtypes.GenerateKey()
+	_, err = wgs.UpdateDevice("wg0", []*pb.DeviceUpdate{
+		{Update: &pb.DeviceUpdate_RemovePeer{RemovePeer: a[:]}},
+		{Update: &pb.DeviceUpdate_AddAllowedIps{AddAllowedIps: &pb.PeerAllowedIPs{PublicKey: missing[:]}}},
+	}, "")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("UpdateDevice of a missing peer: want os.ErrNotExist, got %v", err)
+	}
+	if revision(dev) != before {
+		t.Fatalf("the device was changed by a failed update: %+v", dev)
+	}
+}
+
+func TestUpdateDeviceValidation(t *testing.T) {
+	wgs := WGServer{c: &testClient{}}
+	_, err := wgs.UpdateDevice("", []*pb.DeviceUpdate{
+		{},
+		{Update: &pb.DeviceUpdate_AddAllowedIps{AddAllowedIps: &pb.PeerAllowedIPs{
+			AllowedIps: []*pb.IPNet{{Ip: []byte{10, 7, 0, 1}, IpMask: []byte{255, 255, 255, 0}}},
+		}}},
+		{Update: &pb.DeviceUpdate_RemovePeer{RemovePeer: []byte{1}}},
+	}, "")
+	want := &ValidationError{Violations: []FieldViolation{
+		{Field: "name", Description: "must not be empty"},
+		{Field: "updates[0]", Description: "must set an update"},
+		{Field: "updates[1].add_allowed_ips.public_key", Description: "is required"},
+		{Field: "updates[1].add_allowed_ips.allowed_ips[0]", Description: "10.7.0.1/24 has host bits set, use 10.7.0.0/24"},
+		{Field: "updates[2].remove_peer", Description: "must be 32 bytes, got 1"},
+	}}
+	if diff := cmp.Diff(want, err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+}
+
+// TestUpdateDeviceConcurrent adds allowed IPs to the same peer from many
+// goroutines. Every one of them must end up on the peer.
+func TestUpdateDeviceConcurrent(t *testing.T) {
+	key, _ := wgtypes.GenerateKey()
+	dev := &wgtypes.Device{Name: "wg0", Peers: []wgtypes.Peer{{PublicKey: key}}}
+	wgs := WGServer{c: newSlowKernel(dev)}
+
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = wgs.UpdateDevice("wg0", []*pb.DeviceUpdate{
+				{Update: &pb.DeviceUpdate_AddAllowedIps{AddAllowedIps: &pb.PeerAllowedIPs{
+					PublicKey:  key[:],
+					AllowedIps: []*pb.IPNet{mustIPNet(t, fmt.Sprintf("10.7.0.%d/32", i+1))},
+				}}},
+			}, "")
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("UpdateDevice: %v", err)
+		}
+	}
+	if got := len(dev.Peers[0].AllowedIPs); got != n {
+		t.Fatalf("the peer has %d allowed IPs, want %d", got, n)
+	}
+}
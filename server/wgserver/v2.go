@@ -0,0 +1,325 @@
+package wgserver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/netip"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// keyStringLen is the length of a base64 encoded key, as printed by wg genkey.
+var keyStringLen = base64.StdEncoding.EncodedLen(wgtypes.KeyLen)
+
+// ParseKey decodes a base64 key. An empty string is no key. Field names
+// the value in the returned ValidationError.
+func ParseKey(field, s string) ([]byte, error) {
+	verr := &ValidationError{}
+	key := parseKey(verr, field, s)
+	return key, verr.err()
+}
+
+// ParseIPNet parses a network in CIDR notation like 10.7.0.14/32. Host bits
+// are kept, so it also parses link addresses like 10.7.0.1/24.
+func ParseIPNet(field, s string) (*pb.IPNet, error) {
+	verr := &ValidationError{}
+	ipn := parseIPNet(verr, field, s)
+	return ipn, verr.err()
+}
+
+// ParseEndpoint parses an ip:port endpoint like 203.0.113.1:51820 or
+// [2001:db8::1]:51820. An empty string is no endpoint.
+func ParseEndpoint(field, s string) (*pb.UDPAddr, error) {
+	verr := &ValidationError{}
+	addr := parseEndpoint(verr, field, s)
+	return addr, verr.err()
+}
+
+// FormatKey encodes a key in base64. No key is an empty string.
+func FormatKey(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// FormatIPNet formats a network in CIDR notation, keeping host bits.
+func FormatIPNet(ipn *pb.IPNet) string {
+	if ipn == nil {
+		return ""
+	}
+	return pb2IPNet(ipn).String()
+}
+
+// FormatEndpoint formats an endpoint as ip:port. No endpoint is an empty
+// string.
+func FormatEndpoint(addr *pb.UDPAddr) string {
+	if addr == nil || len(addr.GetIp()) == 0 {
+		return ""
+	}
+	return pb2UDPAddr(addr).String()
+}
+
+// ConfigFromV2 converts a v2 configuration. Every field which can not be
+// parsed is reported in a ValidationError, with paths prefixed by path.
+func ConfigFromV2(path string, cfg *wgv2.Config) (*pb.Config, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	verr := &ValidationError{}
+	c := &pb.Config{
+		PrivateKey:   parseKey(verr, path+".private_key", cfg.GetPrivateKey()),
+		ListenPort:   cfg.ListenPort,
+		FirewallMark: cfg.FirewallMark,
+		ReplacePeers: cfg.GetReplacePeers(),
+	}
+	for i, p := range cfg.GetPeers() {
+		c.Peers = append(c.Peers, peerConfigFromV2(verr, fmt.Sprintf("%s.peers[%d]", path, i), p))
+	}
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// PeerConfigFromV2 converts a v2 peer configuration like ConfigFromV2.
+func PeerConfigFromV2(path string, p *wgv2.PeerConfig) (*pb.PeerConfig, error) {
+	if p == nil {
+		return nil, nil
+	}
+	verr := &ValidationError{}
+	pc := peerConfigFromV2(verr, path, p)
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
+
+func peerConfigFromV2(verr *ValidationError, path string, p *wgv2.PeerConfig) *pb.PeerConfig {
+	pc := &pb.PeerConfig{
+		PublicKey:                   parseKey(verr, path+".public_key", p.GetPublicKey()),
+		Remove:                      p.GetRemove(),
+		UpdateOnly:                  p.GetUpdateOnly(),
+		PresharedKey:                parseKey(verr, path+".preshared_key", p.GetPresharedKey()),
+		Endpoint:                    parseEndpoint(verr, path+".endpoint", p.GetEndpoint()),
+		PersistentKeepaliveInterval: p.GetPersistentKeepaliveInterval(),
+		ReplaceAllowedIps:           p.GetReplaceAllowedIps(),
+	}
+	for i, s := range p.GetAllowedIps() {
+		pc.AllowedIps = append(pc.AllowedIps, parseIPNet(verr, fmt.Sprintf("%s.allowed_ips[%d]", path, i), s))
+	}
+	return pc
+}
+
+// DeviceToV2 converts a device into its v2 form.
+func DeviceToV2(dev *pb.Device) *wgv2.Device {
+	if dev == nil {
+		return nil
+	}
+	d := &wgv2.Device{
+		Name:         dev.GetName(),
+		Type:         wgv2.DeviceType(dev.GetType()),
+		PrivateKey:   FormatKey(dev.GetPrivateKey()),
+		PublicKey:    FormatKey(dev.GetPublicKey()),
+		ListenPort:   dev.GetListenPort(),
+		FirewallMark: dev.GetFirewallMark(),
+		Index:        dev.GetIndex(),
+		Mtu:          dev.GetMtu(),
+		Flags:        dev.GetFlags(),
+		Up:           dev.GetUp(),
+		Revision:     dev.GetRevision(),
+	}
+	for _, p := range dev.GetPeers() {
+		d.Peers = append(d.Peers, PeerToV2(p))
+	}
+	for _, a := range dev.GetAddresses() {
+		d.Addresses = append(d.Addresses, FormatIPNet(a))
+	}
+	return d
+}
+
+// PeerToV2 converts a peer into its v2 form.
+func PeerToV2(p *pb.Peer) *wgv2.Peer {
+	if p == nil {
+		return nil
+	}
+	peer := &wgv2.Peer{
+		PublicKey:                   FormatKey(p.GetPublicKey()),
+		PresharedKey:                FormatKey(p.GetPresharedKey()),
+		Endpoint:                    FormatEndpoint(p.GetEndpoint()),
+		PersistentKeepaliveInterval: p.GetPersistentKeepaliveInterval(),
+		LastHandshakeTime:           p.GetLastHandshakeTime(),
+		ReceiveBytes:                p.GetRecievedBytes(),
+		TransmitBytes:               p.GetTransmitBytes(),
+		ProtocolVersion:             p.GetProtocolVersion(),
+		HasPresharedKey:             p.GetHasPresharedKey(),
+	}
+	for _, a := range p.GetAllowedIps() {
+		peer.AllowedIps = append(peer.AllowedIps, FormatIPNet(a))
+	}
+	return peer
+}
+
+// ParseIPNets parses networks like ParseIPNet. Every invalid network is
+// reported in the ValidationError as path[i].
+func ParseIPNets(path string, ss []string) ([]*pb.IPNet, error) {
+	verr := &ValidationError{}
+	var ipns []*pb.IPNet
+	for i, s := range ss {
+		ipns = append(ipns, parseIPNet(verr, fmt.Sprintf("%s[%d]", path, i), s))
+	}
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	return ipns, nil
+}
+
+// FormatIPNets formats networks like FormatIPNet.
+func FormatIPNets(ipns []*pb.IPNet) []string {
+	var ss []string
+	for _, ipn := range ipns {
+		ss = append(ss, FormatIPNet(ipn))
+	}
+	return ss
+}
+
+// GeneratePeerConfigFromV2 converts a v2 GeneratePeerConfig request like
+// ConfigFromV2.
+func GeneratePeerConfigFromV2(in *wgv2.GeneratePeerConfigRequest) (*pb.GeneratePeerConfigRequest, error) {
+	verr := &ValidationError{}
+	req := &pb.GeneratePeerConfigRequest{
+		Name:                        in.GetName(),
+		PublicKey:                   parseKey(verr, "public_key", in.GetPublicKey()),
+		PrivateKey:                  parseKey(verr, "private_key", in.GetPrivateKey()),
+		EndpointHost:                in.GetEndpointHost(),
+		EndpointPort:                in.GetEndpointPort(),
+		Dns:                         in.GetDns(),
+		TunnelMode:                  pb.TunnelMode(in.GetTunnelMode()),
+		Mtu:                         in.GetMtu(),
+		PersistentKeepaliveInterval: in.GetPersistentKeepaliveInterval(),
+		IncludeSecrets:              in.GetIncludeSecrets(),
+	}
+	for i, s := range in.GetAddresses() {
+		req.Addresses = append(req.Addresses, parseIPNet(verr, fmt.Sprintf("addresses[%d]", i), s))
+	}
+	for i, s := range in.GetAllowedIps() {
+		req.AllowedIps = append(req.AllowedIps, parseIPNet(verr, fmt.Sprintf("allowed_ips[%d]", i), s))
+	}
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// EventToV2 converts a device event into its v2 form.
+func EventToV2(ev *pb.DeviceEvent) *wgv2.DeviceEvent {
+	if ev == nil {
+		return nil
+	}
+	out := &wgv2.DeviceEvent{Device: ev.GetDevice(), Time: ev.GetTime()}
+	switch e := ev.GetEvent().(type) {
+	case *pb.DeviceEvent_Snapshot:
+		out.Event = &wgv2.DeviceEvent_Snapshot{Snapshot: DeviceToV2(e.Snapshot)}
+	case *pb.DeviceEvent_DeviceRemoved:
+		out.Event = &wgv2.DeviceEvent_DeviceRemoved{DeviceRemoved: &wgv2.DeviceRemoved{}}
+	case *pb.DeviceEvent_PeerAdded:
+		out.Event = &wgv2.DeviceEvent_PeerAdded{PeerAdded: &wgv2.PeerAdded{Peer: PeerToV2(e.PeerAdded.GetPeer())}}
+	case *pb.DeviceEvent_PeerRemoved:
+		out.Event = &wgv2.DeviceEvent_PeerRemoved{PeerRemoved: &wgv2.PeerRemoved{
+			PublicKey: FormatKey(e.PeerRemoved.GetPublicKey()),
+		}}
+	case *pb.DeviceEvent_EndpointChanged:
+		out.Event = &wgv2.DeviceEvent_EndpointChanged{EndpointChanged: &wgv2.EndpointChanged{
+			PublicKey: FormatKey(e.EndpointChanged.GetPublicKey()),
+			Previous:  FormatEndpoint(e.EndpointChanged.GetPrevious()),
+			Current:   FormatEndpoint(e.EndpointChanged.GetCurrent()),
+		}}
+	case *pb.DeviceEvent_Handshake:
+		out.Event = &wgv2.DeviceEvent_Handshake{Handshake: &wgv2.Handshake{
+			PublicKey:         FormatKey(e.Handshake.GetPublicKey()),
+			LastHandshakeTime: e.Handshake.GetLastHandshakeTime(),
+		}}
+	case *pb.DeviceEvent_Traffic:
+		out.Event = &wgv2.DeviceEvent_Traffic{Traffic: &wgv2.Traffic{
+			PublicKey:          FormatKey(e.Traffic.GetPublicKey()),
+			ReceivedBytesDelta: e.Traffic.GetReceivedBytesDelta(),
+			TransmitBytesDelta: e.Traffic.GetTransmitBytesDelta(),
+		}}
+	}
+	return out
+}
+
+// AddressPoolsToV2 converts address pools into their v2 form.
+func AddressPoolsToV2(pools *pb.AddressPools) *wgv2.AddressPools {
+	if pools == nil {
+		return nil
+	}
+	out := &wgv2.AddressPools{Name: pools.GetName(), Prefixes: FormatIPNets(pools.GetPrefixes())}
+	for _, l := range pools.GetLeases() {
+		out.Leases = append(out.Leases, &wgv2.Lease{
+			Address:   FormatIPNet(l.GetAddress()),
+			PublicKey: FormatKey(l.GetPublicKey()),
+		})
+	}
+	return out
+}
+
+// parseKey decodes a base64 key. Whitespace, missing padding and keys of
+// the wrong size are rejected.
+func parseKey(verr *ValidationError, field, s string) []byte {
+	if s == "" {
+		return nil
+	}
+	if len(s) != keyStringLen {
+		verr.add(field, "must be %d base64 characters, got %d", keyStringLen, len(s))
+		return nil
+	}
+	key, err := base64.StdEncoding.Strict().DecodeString(s)
+	if err != nil || len(key) != wgtypes.KeyLen {
+		verr.add(field, "%q is not a base64 key", s)
+		return nil
+	}
+	return key
+}
+
+// parseIPNet parses a prefix like 10.7.0.14/32. IPv4 networks use 4 byte
+// IPs and masks.
+func parseIPNet(verr *ValidationError, field, s string) *pb.IPNet {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		verr.add(field, "%q is not a network in CIDR notation like 10.7.0.14/32", s)
+		return nil
+	}
+	addr := prefix.Addr()
+	return &pb.IPNet{
+		Ip:     addr.AsSlice(),
+		IpMask: net.CIDRMask(prefix.Bits(), addr.BitLen()),
+	}
+}
+
+// parseEndpoint parses an ip:port endpoint. Host names are rejected, the
+// kernel only accepts addresses.
+func parseEndpoint(verr *ValidationError, field, s string) *pb.UDPAddr {
+	if s == "" {
+		return nil
+	}
+	ap, err := netip.ParseAddrPort(s)
+	if err != nil {
+		verr.add(field, "%q is not an ip:port endpoint like 203.0.113.1:51820 or [2001:db8::1]:51820", s)
+		return nil
+	}
+	if ap.Port() == 0 {
+		verr.add(field, "port must not be 0")
+		return nil
+	}
+	addr := ap.Addr()
+	return &pb.UDPAddr{
+		Ip:   addr.WithZone("").AsSlice(),
+		Port: int32(ap.Port()),
+		Zone: addr.Zone(),
+	}
+}
@@ -0,0 +1,317 @@
+package wgserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+	wgv2 "github.com/atsevan/wireguard-grpc/pb/wg/v2"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseStrings(t *testing.T) {
+	key := mustKey(t, testPeerKey)
+	tests := []struct {
+		name  string
+		parse func() (proto.Message, error)
+		want  proto.Message
+		err   error
+	}{
+		{
+			name: "Key",
+			parse: func() (proto.Message, error) {
+				k, err := ParseKey("key", testPeerKey)
+				return &pb.Peer{PublicKey: k}, err
+			},
+			want: &pb.Peer{PublicKey: key},
+		},
+		{
+			name:  "KeyWithNewline",
+			parse: func() (proto.Message, error) { _, err := ParseKey("key", testPeerKey+"\n"); return nil, err },
+			err:   invalidField("key", "must be 44 base64 characters, got 45"),
+		},
+		{
+			name:  "KeyNotBase64",
+			parse: func() (proto.Message, error) { _, err := ParseKey("key", "!"+testPeerKey[1:]); return nil, err },
+			err:   invalidField("key", `"!`+testPeerKey[1:]+`" is not a base64 key`),
+		},
+		{
+			name:  "IPv4Net",
+			parse: func() (proto.Message, error) { return ParseIPNet("ip", "10.7.0.14/32") },
+			want:  &pb.IPNet{Ip: []byte{10, 7, 0, 14}, IpMask: []byte{255, 255, 255, 255}},
+		},
+		{
+			name:  "AddressWithHostBits",
+			parse: func() (proto.Message, error) { return ParseIPNet("ip", "fd00::1/64") },
+			want:  &pb.IPNet{Ip: net.ParseIP("fd00::1"), IpMask: net.CIDRMask(64, 128)},
+		},
+		{
+			name:  "IPWithoutPrefix",
+			parse: func() (proto.Message, error) { return ParseIPNet("ip", "10.7.0.14") },
+			err:   invalidField("ip", `"10.7.0.14" is not a network in CIDR notation like 10.7.0.14/32`),
+		},
+		{
+			name:  "LeadingZeros",
+			parse: func() (proto.Message, error) { return ParseIPNet("ip", "10.7.0.014/32") },
+			err:   invalidField("ip", `"10.7.0.014/32" is not a network in CIDR notation like 10.7.0.14/32`),
+		},
+		{
+			name:  "Endpoint",
+			parse: func() (proto.Message, error) { return ParseEndpoint("endpoint", "[fe80::1%eth0]:51820") },
+			want:  &pb.UDPAddr{Ip: net.ParseIP("fe80::1"), Port: 51820, Zone: "eth0"},
+		},
+		{
+			name:  "EndpointHostName",
+			parse: func() (proto.Message, error) { return ParseEndpoint("endpoint", "vpn.example.com:51820") },
+			err:   invalidField("endpoint", `"vpn.example.com:51820" is not an ip:port endpoint like 203.0.113.1:51820 or [2001:db8::1]:51820`),
+		},
+		{
+			name:  "EndpointPortZero",
+			parse: func() (proto.Message, error) { return ParseEndpoint("endpoint", "203.0.113.1:0") },
+			err:   invalidField("endpoint", "port must not be 0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse()
+			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Fatalf("unexpected result (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigFromV2(t *testing.T) {
+	cfg := &wgv2.Config{
+		PrivateKey: testPrivateKey,
+		ListenPort: proto.Int32(51820),
+		Peers: []*wgv2.PeerConfig{{
+			PublicKey:                   testPeerKey,
+			PresharedKey:                testPSK,
+			Endpoint:                    "203.0.113.1:51820",
+			PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+			AllowedIps:                  []string{"10.7.0.14/32", "fd00::e/128"},
+		}},
+	}
+	got, err := ConfigFromV2("config", cfg)
+	if err != nil {
+		t.Fatalf("ConfigFromV2: %v", err)
+	}
+	priv, peer, psk := mustKey(t, testPrivateKey), mustKey(t, testPeerKey), mustKey(t, testPSK)
+	want := &pb.Config{
+		PrivateKey: priv,
+		ListenPort: proto.Int32(51820),
+		Peers: []*pb.PeerConfig{{
+			PublicKey:                   peer,
+			PresharedKey:                psk,
+			Endpoint:                    &pb.UDPAddr{Ip: []byte{203, 0, 113, 1}, Port: 51820},
+			PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+			AllowedIps: []*pb.IPNet{
+				{Ip: []byte{10, 7, 0, 14}, IpMask: []byte{255, 255, 255, 255}},
+				{Ip: net.ParseIP("fd00::e"), IpMask: net.CIDRMask(128, 128)},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected config (-want +got):\n%s", diff)
+	}
+
+	cfg.Peers[0].Endpoint = "203.0.113.1"
+	cfg.Peers[0].AllowedIps[1] = "fd00::e"
+	_, err = ConfigFromV2("config", cfg)
+	wantErr := &ValidationError{Violations: []FieldViolation{
+		{Field: "config.peers[0].endpoint", Description: `"203.0.113.1" is not an ip:port endpoint like 203.0.113.1:51820 or [2001:db8::1]:51820`},
+		{Field: "config.peers[0].allowed_ips[1]", Description: `"fd00::e" is not a network in CIDR notation like 10.7.0.14/32`},
+	}}
+	if diff := cmp.Diff(error(wantErr), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeviceToV2(t *testing.T) {
+	peer := mustKey(t, testPeerKey)
+	dev := &pb.Device{
+		Name:       "wg0",
+		Type:       pb.DeviceType_LINUX_KERNEL,
+		PublicKey:  peer,
+		ListenPort: 51820,
+		Addresses:  []*pb.IPNet{{Ip: []byte{10, 7, 0, 1}, IpMask: []byte{255, 255, 255, 0}}},
+		Peers: []*pb.Peer{{
+			PublicKey:     peer,
+			Endpoint:      &pb.UDPAddr{Ip: net.ParseIP("2001:db8::1"), Port: 51820},
+			RecievedBytes: 10,
+			AllowedIps:    []*pb.IPNet{{Ip: []byte{10, 7, 0, 14}, IpMask: []byte{255, 255, 255, 255}}},
+		}},
+	}
+	want := &wgv2.Device{
+		Name:       "wg0",
+		Type:       wgv2.DeviceType_LINUX_KERNEL,
+		PublicKey:  testPeerKey,
+		ListenPort: 51820,
+		Addresses:  []string{"10.7.0.1/24"},
+		Peers: []*wgv2.Peer{{
+			PublicKey:    testPeerKey,
+			Endpoint:     "[2001:db8::1]:51820",
+			ReceiveBytes: 10,
+			AllowedIps:   []string{"10.7.0.14/32"},
+		}},
+	}
+	if diff := cmp.Diff(want, DeviceToV2(dev), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected device (-want +got):\n%s", diff)
+	}
+	if _, err := wgtypes.ParseKey(DeviceToV2(dev).PublicKey); err != nil {
+		t.Fatalf("public key is not in wg format: %v", err)
+	}
+}
+
+func TestGeneratePeerConfigFromV2(t *testing.T) {
+	in := &wgv2.GeneratePeerConfigRequest{
+		Name:         "wg0",
+		PublicKey:    testPeerKey,
+		PrivateKey:   testPrivateKey,
+		EndpointHost: "vpn.example.com",
+		Addresses:    []string{"10.7.0.14/32"},
+		Dns:          []string{"10.7.0.1"},
+		TunnelMode:   wgv2.TunnelMode_SPLIT_TUNNEL,
+		AllowedIps:   []string{"10.7.0.0/24"},
+	}
+	got, err := GeneratePeerConfigFromV2(in)
+	if err != nil {
+		t.Fatalf("GeneratePeerConfigFromV2: %v", err)
+	}
+	want := &pb.GeneratePeerConfigRequest{
+		Name:         "wg0",
+		PublicKey:    mustKey(t, testPeerKey),
+		PrivateKey:   mustKey(t, testPrivateKey),
+		EndpointHost: "vpn.example.com",
+		Addresses:    []*pb.IPNet{{Ip: []byte{10, 7, 0, 14}, IpMask: []byte{255, 255, 255, 255}}},
+		Dns:          []string{"10.7.0.1"},
+		TunnelMode:   pb.TunnelMode_SPLIT_TUNNEL,
+		AllowedIps:   []*pb.IPNet{{Ip: []byte{10, 7, 0, 0}, IpMask: []byte{255, 255, 255, 0}}},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected request (-want +got):\n%s", diff)
+	}
+
+	in.PrivateKey = "secret"
+	in.AllowedIps = []string{"10.7.0.0"}
+	_, err = GeneratePeerConfigFromV2(in)
+	wantErr := &ValidationError{Violations: []FieldViolation{
+		{Field: "private_key", Description: "must be 44 base64 characters, got 6"},
+		{Field: "allowed_ips[0]", Description: `"10.7.0.0" is not a network in CIDR notation like 10.7.0.14/32`},
+	}}
+	if diff := cmp.Diff(error(wantErr), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+}
+
+func TestEventToV2(t *testing.T) {
+	key := mustKey(t, testPeerKey)
+	now := timestamppb.New(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name string
+		ev   *pb.DeviceEvent
+		want *wgv2.DeviceEvent
+	}{
+		{
+			name: "snapshot",
+			ev:   &pb.DeviceEvent{Event: &pb.DeviceEvent_Snapshot{Snapshot: &pb.Device{Name: "wg0", PublicKey: key}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_Snapshot{Snapshot: &wgv2.Device{Name: "wg0", PublicKey: testPeerKey}}},
+		},
+		{
+			name: "device removed",
+			ev:   &pb.DeviceEvent{Event: &pb.DeviceEvent_DeviceRemoved{DeviceRemoved: &pb.DeviceRemoved{}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_DeviceRemoved{DeviceRemoved: &wgv2.DeviceRemoved{}}},
+		},
+		{
+			name: "peer added",
+			ev:   &pb.DeviceEvent{Event: &pb.DeviceEvent_PeerAdded{PeerAdded: &pb.PeerAdded{Peer: &pb.Peer{PublicKey: key}}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_PeerAdded{PeerAdded: &wgv2.PeerAdded{Peer: &wgv2.Peer{PublicKey: testPeerKey}}}},
+		},
+		{
+			name: "peer removed",
+			ev:   &pb.DeviceEvent{Event: &pb.DeviceEvent_PeerRemoved{PeerRemoved: &pb.PeerRemoved{PublicKey: key}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_PeerRemoved{PeerRemoved: &wgv2.PeerRemoved{PublicKey: testPeerKey}}},
+		},
+		{
+			name: "endpoint changed",
+			ev: &pb.DeviceEvent{Event: &pb.DeviceEvent_EndpointChanged{EndpointChanged: &pb.EndpointChanged{
+				PublicKey: key,
+				Current:   &pb.UDPAddr{Ip: []byte{203, 0, 113, 1}, Port: 51820},
+			}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_EndpointChanged{EndpointChanged: &wgv2.EndpointChanged{
+				PublicKey: testPeerKey,
+				Current:   "203.0.113.1:51820",
+			}}},
+		},
+		{
+			name: "handshake",
+			ev: &pb.DeviceEvent{Event: &pb.DeviceEvent_Handshake{Handshake: &pb.Handshake{
+				PublicKey:         key,
+				LastHandshakeTime: now,
+			}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_Handshake{Handshake: &wgv2.Handshake{
+				PublicKey:         testPeerKey,
+				LastHandshakeTime: now,
+			}}},
+		},
+		{
+			name: "traffic",
+			ev: &pb.DeviceEvent{Event: &pb.DeviceEvent_Traffic{Traffic: &pb.Traffic{
+				PublicKey:          key,
+				ReceivedBytesDelta: 10,
+				TransmitBytesDelta: 20,
+			}}},
+			want: &wgv2.DeviceEvent{Event: &wgv2.DeviceEvent_Traffic{Traffic: &wgv2.Traffic{
+				PublicKey:          testPeerKey,
+				ReceivedBytesDelta: 10,
+				TransmitBytesDelta: 20,
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.ev.Device, tt.ev.Time = "wg0", now
+			tt.want.Device, tt.want.Time = "wg0", now
+			if diff := cmp.Diff(tt.want, EventToV2(tt.ev), protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected event (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddressPoolsToV2(t *testing.T) {
+	pools := &pb.AddressPools{
+		Name:     "wg0",
+		Prefixes: []*pb.IPNet{mustIPNet(t, "10.7.0.0/24"), mustIPNet(t, "fd00::/64")},
+		Leases:   []*pb.Lease{{Address: mustIPNet(t, "10.7.0.2/32"), PublicKey: mustKey(t, testPeerKey)}},
+	}
+	want := &wgv2.AddressPools{
+		Name:     "wg0",
+		Prefixes: []string{"10.7.0.0/24", "fd00::/64"},
+		Leases:   []*wgv2.Lease{{Address: "10.7.0.2/32", PublicKey: testPeerKey}},
+	}
+	if diff := cmp.Diff(want, AddressPoolsToV2(pools), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected pools (-want +got):\n%s", diff)
+	}
+
+	prefixes, err := ParseIPNets("prefixes", want.Prefixes)
+	if err != nil {
+		t.Fatalf("ParseIPNets: %v", err)
+	}
+	if diff := cmp.Diff(pools.Prefixes, prefixes, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected prefixes (-want +got):\n%s", diff)
+	}
+}
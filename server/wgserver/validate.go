@@ -0,0 +1,118 @@
+package wgserver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// maxKeepaliveInterval is the largest persistent keepalive the kernel accepts.
+const maxKeepaliveInterval = math.MaxUint16 * time.Second
+
+// validateConfig checks cfg before anything reaches WGClient and records
+// every problem in verr. Field paths are prefixed by path.
+func validateConfig(verr *ValidationError, path string, cfg *pb.Config) {
+	if cfg == nil {
+		return
+	}
+	validateKey(verr, path+".private_key", cfg.GetPrivateKey(), false)
+	if cfg.ListenPort != nil {
+		validatePort(verr, path+".listen_port", cfg.GetListenPort(), 0)
+	}
+
+	seen := make(map[string]int, len(cfg.GetPeers()))
+	for i, p := range cfg.GetPeers() {
+		peerPath := fmt.Sprintf("%s.peers[%d]", path, i)
+		validatePeerConfig(verr, peerPath, p)
+		if len(p.GetPublicKey()) != wgtypes.KeyLen {
+			continue
+		}
+		key := base64.StdEncoding.EncodeToString(p.GetPublicKey())
+		if j, ok := seen[key]; ok {
+			verr.add(peerPath+".public_key", "duplicates %s.peers[%d].public_key %s", path, j, key)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+// validatePeerConfig checks a single peer configuration.
+func validatePeerConfig(verr *ValidationError, path string, p *pb.PeerConfig) {
+	validateKey(verr, path+".public_key", p.GetPublicKey(), true)
+	validateKey(verr, path+".preshared_key", p.GetPresharedKey(), false)
+	if p.Endpoint != nil {
+		validateIP(verr, path+".endpoint.ip", p.Endpoint.GetIp())
+		validatePort(verr, path+".endpoint.port", p.Endpoint.GetPort(), 1)
+	}
+	if p.PersistentKeepaliveInterval != nil {
+		validateKeepalive(verr, path+".persistent_keepalive_interval", p.PersistentKeepaliveInterval)
+	}
+	for i, ipn := range p.GetAllowedIps() {
+		validateIPNet(verr, fmt.Sprintf("%s.allowed_ips[%d]", path, i), ipn)
+	}
+}
+
+// validateKey checks the key length. An empty key is accepted unless required.
+func validateKey(verr *ValidationError, field string, key []byte, required bool) {
+	switch {
+	case len(key) == 0 && required:
+		verr.add(field, "is required")
+	case len(key) != 0 && len(key) != wgtypes.KeyLen:
+		verr.add(field, "must be %d bytes, got %d", wgtypes.KeyLen, len(key))
+	}
+}
+
+func validatePort(verr *ValidationError, field string, port int32, min int32) {
+	if port < min || port > math.MaxUint16 {
+		verr.add(field, "must be between %d and %d, got %d", min, math.MaxUint16, port)
+	}
+}
+
+func validateIP(verr *ValidationError, field string, ip []byte) bool {
+	if len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+		verr.add(field, "must be %d or %d bytes, got %d", net.IPv4len, net.IPv6len, len(ip))
+		return false
+	}
+	return true
+}
+
+func validateKeepalive(verr *ValidationError, field string, d *durationpb.Duration) {
+	if err := d.CheckValid(); err != nil {
+		verr.add(field, "%s", err)
+		return
+	}
+	switch v := d.AsDuration(); {
+	case v < 0:
+		verr.add(field, "must not be negative, got %s", v)
+	case v > maxKeepaliveInterval:
+		verr.add(field, "must not exceed %s, got %s", maxKeepaliveInterval, v)
+	}
+}
+
+// validateIPNet checks that the IP and mask lengths agree, the mask is a
+// prefix mask and the network is in its canonical form.
+func validateIPNet(verr *ValidationError, path string, ipn *pb.IPNet) {
+	if !validateIP(verr, path+".ip", ipn.GetIp()) {
+		return
+	}
+	ip, mask := net.IP(ipn.GetIp()), net.IPMask(ipn.GetIpMask())
+	if len(mask) != len(ip) {
+		verr.add(path+".ip_mask", "must be %d bytes like the ip, got %d", len(ip), len(mask))
+		return
+	}
+	if _, bits := mask.Size(); bits == 0 {
+		verr.add(path+".ip_mask", "%s is not a prefix mask", mask)
+		return
+	}
+	if network := ip.Mask(mask); !network.Equal(ip) {
+		ones, _ := mask.Size()
+		verr.add(path, "%s/%d has host bits set, use %s/%d", ip, ones, network, ones)
+	}
+}
@@ -0,0 +1,115 @@
+package wgserver
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateConfig(t *testing.T) {
+	privateKey, _ := wgtypes.GeneratePrivateKey()
+	publicKey := privateKey.PublicKey()
+	otherKey, _ := wgtypes.GenerateKey()
+	hostRoute := &pb.IPNet{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}}
+
+	tests := []struct {
+		name string
+		cfg  *pb.Config
+		want []FieldViolation
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name: "valid config",
+			cfg: &pb.Config{
+				PrivateKey: privateKey[:],
+				ListenPort: proto.Int32(51820),
+				Peers: []*pb.PeerConfig{{
+					PublicKey:                   publicKey[:],
+					PresharedKey:                otherKey[:],
+					Endpoint:                    &pb.UDPAddr{Ip: []byte{192, 0, 2, 1}, Port: 51820},
+					PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+					AllowedIps: []*pb.IPNet{
+						hostRoute,
+						{Ip: make([]byte, 16), IpMask: make([]byte, 16)},
+					},
+				}},
+			},
+		},
+		{
+			name: "bad keys and ports",
+			cfg: &pb.Config{
+				PrivateKey: []byte{1, 2, 3},
+				ListenPort: proto.Int32(70000),
+				Peers: []*pb.PeerConfig{
+					{PresharedKey: []byte{1}},
+					{
+						PublicKey: publicKey[:],
+						Endpoint:  &pb.UDPAddr{Ip: []byte{1, 2, 3}, Port: 0},
+					},
+				},
+			},
+			want: []FieldViolation{
+				{Field: "config.private_key", Description: "must be 32 bytes, got 3"},
+				{Field: "config.listen_port", Description: "must be between 0 and 65535, got 70000"},
+				{Field: "config.peers[0].public_key", Description: "is required"},
+				{Field: "config.peers[0].preshared_key", Description: "must be 32 bytes, got 1"},
+				{Field: "config.peers[1].endpoint.ip", Description: "must be 4 or 16 bytes, got 3"},
+				{Field: "config.peers[1].endpoint.port", Description: "must be between 1 and 65535, got 0"},
+			},
+		},
+		{
+			name: "bad allowed ips",
+			cfg: &pb.Config{
+				Peers: []*pb.PeerConfig{{
+					PublicKey: publicKey[:],
+					AllowedIps: []*pb.IPNet{
+						{Ip: []byte{10, 7, 0, 2}, IpMask: make([]byte, 16)},
+						{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 0, 255, 0}},
+						{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 0}},
+						{Ip: []byte{10}, IpMask: []byte{255}},
+					},
+				}},
+			},
+			want: []FieldViolation{
+				{Field: "config.peers[0].allowed_ips[0].ip_mask", Description: "must be 4 bytes like the ip, got 16"},
+				{Field: "config.peers[0].allowed_ips[1].ip_mask", Description: "ff00ff00 is not a prefix mask"},
+				{Field: "config.peers[0].allowed_ips[2]", Description: "10.7.0.2/24 has host bits set, use 10.7.0.0/24"},
+				{Field: "config.peers[0].allowed_ips[3].ip", Description: "must be 4 or 16 bytes, got 1"},
+			},
+		},
+		{
+			name: "bad keepalive and duplicate peers",
+			cfg: &pb.Config{
+				Peers: []*pb.PeerConfig{
+					{PublicKey: publicKey[:], PersistentKeepaliveInterval: durationpb.New(-time.Second)},
+					{PublicKey: otherKey[:], PersistentKeepaliveInterval: durationpb.New(24 * time.Hour)},
+					{PublicKey: publicKey[:], AllowedIps: []*pb.IPNet{hostRoute}},
+				},
+			},
+			want: []FieldViolation{
+				{Field: "config.peers[0].persistent_keepalive_interval", Description: "must not be negative, got -1s"},
+				{Field: "config.peers[1].persistent_keepalive_interval", Description: "must not exceed 18h12m15s, got 24h0m0s"},
+				{Field: "config.peers[2].public_key", Description: "duplicates config.peers[0].public_key " + publicKey.String()},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr := &ValidationError{}
+			validateConfig(verr, "config", tt.cfg)
+			if diff := cmp.Diff(tt.want, verr.Violations); diff != "" {
+				t.Fatalf("unexpected violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package wgserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchDevice polls a WireGuard device every interval and passes its changes
+// to fn, starting with a snapshot. It returns when ctx is done or fn fails.
+// Watches of the same device and interval share the polls.
+//
+// If the device does not exist when the watch starts, an error is returned
+// which can be checked using `errors.Is(err, os.ErrNotExist)`.
+func (wgs *WGServer) WatchDevice(ctx context.Context, name string, interval time.Duration, fn func(*pb.DeviceEvent) error) error {
+	if name == "" {
+		return invalidField("name", "must not be empty")
+	}
+	return wgs.watch(ctx, watchKey{name: name, interval: interval}, fn)
+}
+
+// WatchDevices polls all WireGuard devices every interval and passes their
+// changes to fn, starting with a snapshot of every device. It returns when
+// ctx is done or fn fails. Watches with the same interval share the polls.
+func (wgs *WGServer) WatchDevices(ctx context.Context, interval time.Duration, fn func(*pb.DeviceEvent) error) error {
+	return wgs.watch(ctx, watchKey{interval: interval}, fn)
+}
+
+// watchBacklog is the number of polls a watcher may fall behind before it
+// is dropped, so a slow client doesn't hold up the others.
+const watchBacklog = 16
+
+// watchers shares a poller between the watches of the same devices and
+// interval, so every dashboard doesn't poll the kernel on its own.
+type watchers struct {
+	mu      sync.Mutex
+	pollers map[watchKey]*poller
+}
+
+// watchKey identifies the polls of a device, or all devices if name is
+// empty, every interval.
+type watchKey struct {
+	name     string
+	interval time.Duration
+}
+
+// poller polls the devices of its key and passes the events to its
+// watchers. It stops when the last watcher leaves or a poll fails.
+type poller struct {
+	key  watchKey
+	stop chan struct{}
+	// devices is the last poll, watchers joining get a snapshot of it.
+	// It is only written by the poller under watchers.mu.
+	devices  map[string]*wgtypes.Device
+	watchers map[*watcher]bool
+}
+
+// watcher is the queue of events of a watch.
+type watcher struct {
+	events chan []*pb.DeviceEvent
+	// done is closed when the poller fails or drops the watcher, err
+	// tells why.
+	done chan struct{}
+	err  error
+}
+
+func (wgs *WGServer) watch(ctx context.Context, key watchKey, fn func(*pb.DeviceEvent) error) error {
+	if key.interval <= 0 {
+		return invalidField("interval", "must be positive")
+	}
+	p, w, err := wgs.subscribe(key)
+	if err != nil {
+		return err
+	}
+	defer wgs.unsubscribe(p, w)
+
+	send := func(events []*pb.DeviceEvent) error {
+		for _, ev := range events {
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for {
+		select {
+		case events := <-w.events:
+			if err := send(events); err != nil {
+				return err
+			}
+		case <-w.done:
+			// Pass on the events queued before the failure.
+			for {
+				select {
+				case events := <-w.events:
+					if err := send(events); err != nil {
+						return err
+					}
+				default:
+					return w.err
+				}
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// subscribe adds a watcher to the poller of key, which is started if
+// needed, and queues a snapshot of the last poll for it.
+func (wgs *WGServer) subscribe(key watchKey) (*poller, *watcher, error) {
+	ws := &wgs.watchers
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	p, ok := ws.pollers[key]
+	if !ok {
+		// The first poll runs under the lock, so watches starting at
+		// the same time don't poll twice.
+		devices, err := wgs.poll(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		p = &poller{
+			key:      key,
+			stop:     make(chan struct{}),
+			devices:  devicesByName(devices),
+			watchers: map[*watcher]bool{},
+		}
+		if ws.pollers == nil {
+			ws.pollers = map[watchKey]*poller{}
+		}
+		if key.name != "" && len(devices) == 0 {
+			return nil, nil, fmt.Errorf("device %s: %w", key.name, os.ErrNotExist)
+		}
+		ws.pollers[key] = p
+		go wgs.runPoller(p)
+	}
+	if key.name != "" && len(p.devices) == 0 {
+		return nil, nil, fmt.Errorf("device %s: %w", key.name, os.ErrNotExist)
+	}
+
+	now := time.Now()
+	var snapshot []*pb.DeviceEvent
+	for _, name := range sortedNames(p.devices) {
+		ev, err := wgs.snapshotEvent(p.devices[name], now)
+		if err != nil {
+			wgs.stopIdle(p)
+			return nil, nil, err
+		}
+		snapshot = append(snapshot, ev)
+	}
+	w := &watcher{events: make(chan []*pb.DeviceEvent, watchBacklog), done: make(chan struct{})}
+	if len(snapshot) > 0 {
+		w.events <- snapshot
+	}
+	p.watchers[w] = true
+	return p, w, nil
+}
+
+// unsubscribe removes a watcher and stops its poller after the last one.
+func (wgs *WGServer) unsubscribe(p *poller, w *watcher) {
+	wgs.watchers.mu.Lock()
+	defer wgs.watchers.mu.Unlock()
+	delete(p.watchers, w)
+	wgs.stopIdle(p)
+}
+
+// stopIdle stops a poller without watchers. watchers.mu must be held.
+func (wgs *WGServer) stopIdle(p *poller) {
+	if len(p.watchers) > 0 || wgs.watchers.pollers[p.key] != p {
+		return
+	}
+	delete(wgs.watchers.pollers, p.key)
+	close(p.stop)
+}
+
+// poll reads the devices of key. A missing device is no error, it has been
+// removed since the last poll.
+func (wgs *WGServer) poll(key watchKey) ([]*wgtypes.Device, error) {
+	if key.name == "" {
+		return wgs.c.Devices()
+	}
+	dev, err := wgs.c.Device(key.name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return []*wgtypes.Device{dev}, nil
+}
+
+func (wgs *WGServer) runPoller(p *poller) {
+	ticker := time.NewTicker(p.key.interval)
+	defer ticker.Stop()
+	prev := p.devices
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+		devices, err := wgs.poll(p.key)
+		var events []*pb.DeviceEvent
+		if err == nil {
+			events, err = wgs.pollEvents(prev, devicesByName(devices), time.Now())
+		}
+
+		ws := &wgs.watchers
+		ws.mu.Lock()
+		if ws.pollers[p.key] != p {
+			ws.mu.Unlock()
+			return
+		}
+		if err != nil {
+			delete(ws.pollers, p.key)
+			for w := range p.watchers {
+				w.fail(err)
+			}
+			ws.mu.Unlock()
+			return
+		}
+		prev = devicesByName(devices)
+		p.devices = prev
+		if len(events) > 0 {
+			for w := range p.watchers {
+				select {
+				case w.events <- events:
+				default:
+					delete(p.watchers, w)
+					w.fail(status.Error(codes.ResourceExhausted, "the watch fell behind, watch again"))
+				}
+			}
+			wgs.stopIdle(p)
+		}
+		ws.mu.Unlock()
+	}
+}
+
+// fail ends the watch with err. watchers.mu must be held.
+func (w *watcher) fail(err error) {
+	w.err = err
+	close(w.done)
+}
+
+// pollEvents returns the events between two polls: snapshots of new
+// devices, the changes of the others and the removed devices.
+func (wgs *WGServer) pollEvents(prev, cur map[string]*wgtypes.Device, now time.Time) ([]*pb.DeviceEvent, error) {
+	var events []*pb.DeviceEvent
+	for _, name := range sortedNames(cur) {
+		dev := cur[name]
+		if p, ok := prev[name]; ok {
+			events = append(events, deviceEvents(p, dev, now)...)
+			continue
+		}
+		ev, err := wgs.snapshotEvent(dev, now)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	for _, name := range sortedNames(prev) {
+		if _, ok := cur[name]; !ok {
+			events = append(events, &pb.DeviceEvent{
+				Device: name,
+				Time:   timestamppb.New(now),
+				Event:  &pb.DeviceEvent_DeviceRemoved{DeviceRemoved: &pb.DeviceRemoved{}},
+			})
+		}
+	}
+	return events, nil
+}
+
+func devicesByName(devices []*wgtypes.Device) map[string]*wgtypes.Device {
+	m := make(map[string]*wgtypes.Device, len(devices))
+	for _, dev := range devices {
+		m[dev.Name] = dev
+	}
+	return m
+}
+
+func (wgs *WGServer) snapshotEvent(dev *wgtypes.Device, now time.Time) (*pb.DeviceEvent, error) {
+	pbDev, err := convertWGDeviceToPb(dev)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s to PB: %w", dev.Name, err)
+	}
+	wgs.setLinkInfo(pbDev)
+	return &pb.DeviceEvent{
+		Device: dev.Name,
+		Time:   timestamppb.New(now),
+		Event:  &pb.DeviceEvent_Snapshot{Snapshot: pbDev},
+	}, nil
+}
+
+// deviceEvents returns the peer events between two polls of a device.
+func deviceEvents(prev, cur *wgtypes.Device, now time.Time) []*pb.DeviceEvent {
+	var events []*pb.DeviceEvent
+	for i := range cur.Peers {
+		p := &cur.Peers[i]
+		key := wgKey2pbKey(&p.PublicKey)
+		old := findPeer(prev, p.PublicKey)
+		if old == nil {
+			events = append(events, &pb.DeviceEvent{Device: cur.Name, Time: timestamppb.New(now), Event: &pb.DeviceEvent_PeerAdded{
+				PeerAdded: &pb.PeerAdded{Peer: convertWGPeerToPb(p)},
+			}})
+			continue
+		}
+		if udpAddrString(old.Endpoint) != udpAddrString(p.Endpoint) {
+			events = append(events, &pb.DeviceEvent{Device: cur.Name, Time: timestamppb.New(now), Event: &pb.DeviceEvent_EndpointChanged{
+				EndpointChanged: &pb.EndpointChanged{
+					PublicKey: key,
+					Previous:  udpAddr2Pb(old.Endpoint),
+					Current:   udpAddr2Pb(p.Endpoint),
+				},
+			}})
+		}
+		if p.LastHandshakeTime.After(old.LastHandshakeTime) {
+			events = append(events, &pb.DeviceEvent{Device: cur.Name, Time: timestamppb.New(now), Event: &pb.DeviceEvent_Handshake{
+				Handshake: &pb.Handshake{
+					PublicKey:         key,
+					LastHandshakeTime: timestamppb.New(p.LastHandshakeTime),
+				},
+			}})
+		}
+		rx, tx := counterDelta(old.ReceiveBytes, p.ReceiveBytes), counterDelta(old.TransmitBytes, p.TransmitBytes)
+		if rx != 0 || tx != 0 {
+			events = append(events, &pb.DeviceEvent{Device: cur.Name, Time: timestamppb.New(now), Event: &pb.DeviceEvent_Traffic{
+				Traffic: &pb.Traffic{
+					PublicKey:          key,
+					ReceivedBytesDelta: rx,
+					TransmitBytesDelta: tx,
+				},
+			}})
+		}
+	}
+	for i := range prev.Peers {
+		p := &prev.Peers[i]
+		if findPeer(cur, p.PublicKey) == nil {
+			events = append(events, &pb.DeviceEvent{Device: cur.Name, Time: timestamppb.New(now), Event: &pb.DeviceEvent_PeerRemoved{
+				PeerRemoved: &pb.PeerRemoved{PublicKey: wgKey2pbKey(&p.PublicKey)},
+			}})
+		}
+	}
+	return events
+}
+
+// counterDelta returns the increase of a counter. A counter which went down
+// has been reset, e.g. by re-adding the peer, so its value is the delta.
+func counterDelta(prev, cur int64) int64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+func udpAddrString(addr *net.UDPAddr) string {
+	if addr == nil {
+		return ""
+	}
+	return addr.String()
+}
+
+func sortedNames(devices map[string]*wgtypes.Device) []string {
+	names := make([]string, 0, len(devices))
+	for name := range devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,219 @@
+package wgserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDeviceEvents(t *testing.T) {
+	kept, _ := wgtypes.GenerateKey()
+	added, _ := wgtypes.GenerateKey()
+	removed, _ := wgtypes.GenerateKey()
+	handshake := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	endpoint := &net.UDPAddr{IP: net.IP{192, 0, 2, 1}, Port: 51820}
+	roamed := &net.UDPAddr{IP: net.IP{192, 0, 2, 2}, Port: 51820}
+
+	prev := &wgtypes.Device{
+		Name: "wg0",
+		Peers: []wgtypes.Peer{
+			{PublicKey: kept, Endpoint: endpoint, LastHandshakeTime: handshake, ReceiveBytes: 100, TransmitBytes: 200},
+			{PublicKey: removed},
+		},
+	}
+	cur := &wgtypes.Device{
+		Name: "wg0",
+		Peers: []wgtypes.Peer{
+			{PublicKey: kept, Endpoint: roamed, LastHandshakeTime: handshake.Add(time.Minute), ReceiveBytes: 150, TransmitBytes: 200},
+			{PublicKey: added},
+		},
+	}
+
+	want := []*pb.DeviceEvent{
+		{Device: "wg0", Event: &pb.DeviceEvent_EndpointChanged{EndpointChanged: &pb.EndpointChanged{
+			PublicKey: kept[:],
+			Previous:  &pb.UDPAddr{Ip: endpoint.IP, Port: 51820},
+			Current:   &pb.UDPAddr{Ip: roamed.IP, Port: 51820},
+		}}},
+		{Device: "wg0", Event: &pb.DeviceEvent_Handshake{Handshake: &pb.Handshake{
+			PublicKey:         kept[:],
+			LastHandshakeTime: timestamppb.New(handshake.Add(time.Minute)),
+		}}},
+		{Device: "wg0", Event: &pb.DeviceEvent_Traffic{Traffic: &pb.Traffic{
+			PublicKey:          kept[:],
+			ReceivedBytesDelta: 50,
+		}}},
+		{Device: "wg0", Event: &pb.DeviceEvent_PeerAdded{PeerAdded: &pb.PeerAdded{
+			Peer: convertWGPeerToPb(&cur.Peers[1]),
+		}}},
+		{Device: "wg0", Event: &pb.DeviceEvent_PeerRemoved{PeerRemoved: &pb.PeerRemoved{
+			PublicKey: removed[:],
+		}}},
+	}
+	got := deviceEvents(prev, cur, time.Now())
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&pb.DeviceEvent{}, "time")); diff != "" {
+		t.Fatalf("unexpected events (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(int64(20), counterDelta(100, 20)); diff != "" {
+		t.Fatalf("reset counter (-want +got):\n%s", diff)
+	}
+}
+
+func TestWatchDevice(t *testing.T) {
+	key, _ := wgtypes.GenerateKey()
+	polls := []*wgtypes.Device{
+		{Name: "wg0"},
+		{Name: "wg0", Peers: []wgtypes.Peer{{PublicKey: key}}},
+	}
+	wgs := WGServer{c: &testClient{
+		DeviceFunc: func(name string) (*wgtypes.Device, error) {
+			if len(polls) == 0 {
+				return nil, os.ErrNotExist
+			}
+			dev := polls[0]
+			polls = polls[1:]
+			return dev, nil
+		},
+	}}
+
+	var got []string
+	err := wgs.WatchDevice(context.Background(), "wg0", time.Millisecond, func(ev *pb.DeviceEvent) error {
+		switch ev.Event.(type) {
+		case *pb.DeviceEvent_Snapshot:
+			got = append(got, "snapshot")
+		case *pb.DeviceEvent_PeerAdded:
+			got = append(got, "peer_added")
+		case *pb.DeviceEvent_DeviceRemoved:
+			got = append(got, "device_removed")
+			return context.Canceled
+		default:
+			t.Errorf("unexpected event %v", ev)
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WatchDevice: want context.Canceled, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"snapshot", "peer_added", "device_removed"}, got); diff != "" {
+		t.Fatalf("unexpected events (-want +got):\n%s", diff)
+	}
+
+	// A missing device fails the watch before any event.
+	err = wgs.WatchDevice(context.Background(), "wg1", time.Millisecond, func(*pb.DeviceEvent) error {
+		t.Fatal("unexpected event")
+		return nil
+	})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("WatchDevice of a missing device: want os.ErrNotExist, got %v", err)
+	}
+}
+
+// TestWatchDeviceSharesPolls watches a device twice, the watches must share
+// the polls of the kernel.
+func TestWatchDeviceSharesPolls(t *testing.T) {
+	key, _ := wgtypes.GenerateKey()
+	var mu sync.Mutex
+	polls := 0
+	dev := &wgtypes.Device{Name: "wg0"}
+	// Every poll after the first waits for the test.
+	next := make(chan *wgtypes.Device)
+	wgs := &WGServer{c: &testClient{
+		DeviceFunc: func(name string) (*wgtypes.Device, error) {
+			mu.Lock()
+			polls++
+			first := polls == 1
+			mu.Unlock()
+			if first {
+				return dev, nil
+			}
+			if d, ok := <-next; ok {
+				return d, nil
+			}
+			return nil, os.ErrNotExist
+		},
+	}}
+	pollCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return polls
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	type result struct {
+		events chan string
+		err    chan error
+	}
+	watch := func() result {
+		r := result{events: make(chan string, 10), err: make(chan error, 1)}
+		go func() {
+			r.err <- wgs.WatchDevice(ctx, "wg0", time.Millisecond, func(ev *pb.DeviceEvent) error {
+				switch ev.Event.(type) {
+				case *pb.DeviceEvent_Snapshot:
+					r.events <- "snapshot"
+				case *pb.DeviceEvent_PeerAdded:
+					r.events <- "peer_added"
+				default:
+					r.events <- "other"
+				}
+				return nil
+			})
+		}()
+		return r
+	}
+	expect := func(r result, want string) {
+		t.Helper()
+		select {
+		case got := <-r.events:
+			if got != want {
+				t.Fatalf("got event %s, want %s", got, want)
+			}
+		case err := <-r.err:
+			t.Fatalf("WatchDevice: %v", err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("no %s event", want)
+		}
+	}
+
+	a := watch()
+	expect(a, "snapshot")
+	b := watch()
+	expect(b, "snapshot")
+	if n := pollCount(); n != 1 {
+		t.Fatalf("two watches starting polled %d times, want 1", n)
+	}
+
+	// One poll reaches both watches.
+	next <- &wgtypes.Device{Name: "wg0", Peers: []wgtypes.Peer{{PublicKey: key}}}
+	expect(a, "peer_added")
+	expect(b, "peer_added")
+	// The first poll, the one above and the next one, which waits.
+	if n := pollCount(); n > 3 {
+		t.Fatalf("the watches polled %d times, want at most 3", n)
+	}
+
+	// The poller stops with the last watch.
+	cancel()
+	for _, r := range []result{a, b} {
+		if err := <-r.err; !errors.Is(err, context.Canceled) {
+			t.Fatalf("WatchDevice: want context.Canceled, got %v", err)
+		}
+	}
+	close(next)
+	wgs.watchers.mu.Lock()
+	defer wgs.watchers.mu.Unlock()
+	if n := len(wgs.watchers.pollers); n != 0 {
+		t.Fatalf("%d pollers are left", n)
+	}
+}
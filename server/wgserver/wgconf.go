@@ -0,0 +1,244 @@
+package wgserver
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ExportConfig renders a WireGuard device as a configuration file.
+//
+// Private and preshared keys are only written if includeSecrets is set.
+func (wgs *WGServer) ExportConfig(name string, format pb.ConfigFormat, includeSecrets bool) (string, error) {
+	dev, err := wgs.Device(name)
+	if err != nil {
+		return "", err
+	}
+	if !includeSecrets {
+		RedactSecrets(dev)
+	}
+	return renderConfig(dev, format), nil
+}
+
+// ImportConfig replaces the configuration of a WireGuard device by a
+// configuration file, like `wg setconf` does, and returns the device.
+//
+// Parse errors are returned as a ValidationError matching os.ErrInvalid.
+// The device revision is checked like by ConfigureDevice.
+func (wgs *WGServer) ImportConfig(name string, config string, revision string) (*pb.Device, error) {
+	cfg, err := parseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := wgs.ConfigureDevice(name, cfg, revision); err != nil {
+		return nil, err
+	}
+	return wgs.Device(name)
+}
+
+// renderConfig writes dev in the format of `wg showconf`. The wg-quick format
+// adds the link addresses and MTU when they are known.
+func renderConfig(dev *pb.Device, format pb.ConfigFormat) string {
+	var b strings.Builder
+	b.WriteString("[Interface]\n")
+	if format == pb.ConfigFormat_WG_QUICK {
+		for _, a := range dev.GetAddresses() {
+			fmt.Fprintf(&b, "Address = %s\n", (&net.IPNet{IP: a.GetIp(), Mask: a.GetIpMask()}).String())
+		}
+		if dev.GetMtu() > 0 {
+			fmt.Fprintf(&b, "MTU = %d\n", dev.GetMtu())
+		}
+	}
+	if dev.GetListenPort() != 0 {
+		fmt.Fprintf(&b, "ListenPort = %d\n", dev.GetListenPort())
+	}
+	if dev.GetFirewallMark() != 0 {
+		fmt.Fprintf(&b, "FwMark = 0x%x\n", uint32(dev.GetFirewallMark()))
+	}
+	if isSetKey(dev.GetPrivateKey()) {
+		fmt.Fprintf(&b, "PrivateKey = %s\n", base64.StdEncoding.EncodeToString(dev.GetPrivateKey()))
+	}
+	for _, p := range dev.GetPeers() {
+		b.WriteString("\n[Peer]\n")
+		fmt.Fprintf(&b, "PublicKey = %s\n", base64.StdEncoding.EncodeToString(p.GetPublicKey()))
+		if p.GetHasPresharedKey() && isSetKey(p.GetPresharedKey()) {
+			fmt.Fprintf(&b, "PresharedKey = %s\n", base64.StdEncoding.EncodeToString(p.GetPresharedKey()))
+		}
+		if len(p.GetAllowedIps()) > 0 {
+			ips := make([]string, 0, len(p.GetAllowedIps()))
+			for _, ipn := range p.GetAllowedIps() {
+				ips = append(ips, pb2IPNet(ipn).String())
+			}
+			fmt.Fprintf(&b, "AllowedIPs = %s\n", strings.Join(ips, ", "))
+		}
+		if p.GetEndpoint() != nil {
+			fmt.Fprintf(&b, "Endpoint = %s\n", pb2UDPAddr(p.GetEndpoint()))
+		}
+		if ka := p.GetPersistentKeepaliveInterval().AsDuration(); ka > 0 {
+			fmt.Fprintf(&b, "PersistentKeepalive = %d\n", int(ka.Seconds()))
+		}
+	}
+	return b.String()
+}
+
+// isSetKey reports whether key is present and not all zeros.
+func isSetKey(key []byte) bool {
+	return len(key) == wgtypes.KeyLen && wgtypes.Key(key) != wgtypes.Key{}
+}
+
+// wgQuickKeys are [Interface] keys only interpreted by wg-quick.
+var wgQuickKeys = map[string]bool{
+	"address":    true,
+	"dns":        true,
+	"mtu":        true,
+	"table":      true,
+	"preup":      true,
+	"postup":     true,
+	"predown":    true,
+	"postdown":   true,
+	"saveconfig": true,
+}
+
+// parseConfig parses a `wg setconf` or wg-quick configuration file into a
+// configuration replacing the whole device.
+func parseConfig(config string) (*pb.Config, error) {
+	verr := &ValidationError{}
+	cfg := &pb.Config{ReplacePeers: true}
+	var peer *pb.PeerConfig
+	section := ""
+	s := bufio.NewScanner(strings.NewReader(config))
+	for n := 1; s.Scan(); n++ {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.ToLower(strings.TrimSpace(line[1 : len(line)-1]))
+			switch section {
+			case "interface":
+			case "peer":
+				peer = &pb.PeerConfig{ReplaceAllowedIps: true}
+				cfg.Peers = append(cfg.Peers, peer)
+			default:
+				verr.add("config", "line %d: unknown section %s", n, line)
+			}
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			verr.add("config", "line %d: want key = value", n)
+			continue
+		}
+		key, value := strings.TrimSpace(k), strings.TrimSpace(v)
+		var err error
+		switch {
+		case section == "interface":
+			err = parseInterfaceKey(cfg, key, value)
+		case section == "peer":
+			err = parsePeerKey(peer, key, value)
+		default:
+			err = fmt.Errorf("%s outside of a section", key)
+		}
+		if err != nil {
+			verr.add("config", "line %d: %s", n, err)
+		}
+	}
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func parseInterfaceKey(cfg *pb.Config, key, value string) error {
+	switch strings.ToLower(key) {
+	case "privatekey":
+		k, err := wgtypes.ParseKey(value)
+		if err != nil {
+			return fmt.Errorf("PrivateKey: %w", err)
+		}
+		cfg.PrivateKey = k[:]
+	case "listenport":
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return fmt.Errorf("ListenPort: invalid port %q", value)
+		}
+		p := int32(port)
+		cfg.ListenPort = &p
+	case "fwmark":
+		var mark uint64
+		if value != "off" {
+			var err error
+			// firewall_mark is an int32, larger marks don't fit.
+			if mark, err = strconv.ParseUint(value, 0, 31); err != nil {
+				return fmt.Errorf("FwMark: invalid mark %q, want at most 0x7fffffff", value)
+			}
+		}
+		m := int32(mark)
+		cfg.FirewallMark = &m
+	default:
+		if !wgQuickKeys[strings.ToLower(key)] {
+			return fmt.Errorf("unknown [Interface] key %s", key)
+		}
+	}
+	return nil
+}
+
+func parsePeerKey(peer *pb.PeerConfig, key, value string) error {
+	switch strings.ToLower(key) {
+	case "publickey":
+		k, err := wgtypes.ParseKey(value)
+		if err != nil {
+			return fmt.Errorf("PublicKey: %w", err)
+		}
+		peer.PublicKey = k[:]
+	case "presharedkey":
+		k, err := wgtypes.ParseKey(value)
+		if err != nil {
+			return fmt.Errorf("PresharedKey: %w", err)
+		}
+		peer.PresharedKey = k[:]
+	case "allowedips":
+		for _, s := range strings.Split(value, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			_, ipn, err := net.ParseCIDR(s)
+			if err != nil {
+				return fmt.Errorf("AllowedIPs: invalid network %q", s)
+			}
+			peer.AllowedIps = append(peer.AllowedIps, &pb.IPNet{Ip: ipn.IP, IpMask: ipn.Mask})
+		}
+	case "endpoint":
+		// Host names are not resolved: that would block the call on DNS
+		// and store whatever address resolved at import time.
+		addr, err := netip.ParseAddrPort(value)
+		if err != nil {
+			return fmt.Errorf("Endpoint: %q is not an IP address and port, host names are not resolved", value)
+		}
+		ip := addr.Addr().Unmap()
+		peer.Endpoint = &pb.UDPAddr{Ip: ip.WithZone("").AsSlice(), Port: int32(addr.Port()), Zone: ip.Zone()}
+	case "persistentkeepalive":
+		var seconds uint64
+		if value != "off" {
+			var err error
+			if seconds, err = strconv.ParseUint(value, 10, 16); err != nil {
+				return fmt.Errorf("PersistentKeepalive: invalid interval %q", value)
+			}
+		}
+		peer.PersistentKeepaliveInterval = durationpb.New(time.Duration(seconds) * time.Second)
+	default:
+		return fmt.Errorf("unknown [Peer] key %s", key)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package wgserver
+
+import (
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/atsevan/wireguard-grpc/pb/wg"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	testPrivateKey = "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+	testPeerKey    = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+	testPSK        = "/UwcSPg38hW/D9Y3tcS1FOV0K1wuURMbS0sesJEP5ak="
+)
+
+func mustKey(t *testing.T, s string) []byte {
+	t.Helper()
+	k, err := wgtypes.ParseKey(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k[:]
+}
+
+func TestRenderConfig(t *testing.T) {
+	dev := &pb.Device{
+		Name:         "wg0",
+		PrivateKey:   mustKey(t, testPrivateKey),
+		ListenPort:   51820,
+		FirewallMark: 0x20,
+		Mtu:          1420,
+		Addresses:    []*pb.IPNet{{Ip: []byte{10, 7, 0, 1}, IpMask: []byte{255, 255, 255, 0}}},
+		Peers: []*pb.Peer{{
+			PublicKey:                   mustKey(t, testPeerKey),
+			PresharedKey:                mustKey(t, testPSK),
+			HasPresharedKey:             true,
+			Endpoint:                    &pb.UDPAddr{Ip: []byte{192, 0, 2, 1}, Port: 51820},
+			PersistentKeepaliveInterval: durationpb.New(25 * time.Second),
+			AllowedIps: []*pb.IPNet{
+				{Ip: []byte{10, 7, 0, 2}, IpMask: []byte{255, 255, 255, 255}},
+				{Ip: []byte{10, 8, 0, 0}, IpMask: []byte{255, 255, 0, 0}},
+			},
+		}},
+	}
+	setconf := `[Interface]
+ListenPort = 51820
+FwMark = 0x20
+PrivateKey = ` + testPrivateKey + `
+
+[Peer]
+PublicKey = ` + testPeerKey + `
+PresharedKey = ` + testPSK + `
+AllowedIPs = 10.7.0.2/32, 10.8.0.0/16
+Endpoint = 192.0.2.1:51820
+PersistentKeepalive = 25
+`
+	if diff := cmp.Diff(setconf, renderConfig(dev, pb.ConfigFormat_SETCONF)); diff != "" {
+		t.Fatalf("unexpected setconf file (-want +got):\n%s", diff)
+	}
+	quick := "[Interface]\nAddress = 10.7.0.1/24\nMTU = 1420\n" + setconf[len("[Interface]\n"):]
+	if diff := cmp.Diff(quick, renderConfig(dev, pb.ConfigFormat_WG_QUICK)); diff != "" {
+		t.Fatalf("unexpected wg-quick file (-want +got):\n%s", diff)
+	}
+
+	// Parsing the file gives the configuration back.
+	want := &pb.Config{
+		PrivateKey:   dev.PrivateKey,
+		ListenPort:   proto.Int32(51820),
+		FirewallMark: proto.Int32(0x20),
+		ReplacePeers: true,
+		Peers: []*pb.PeerConfig{{
+			PublicKey:                   dev.Peers[0].PublicKey,
+			PresharedKey:                dev.Peers[0].PresharedKey,
+			Endpoint:                    dev.Peers[0].Endpoint,
+			PersistentKeepaliveInterval: dev.Peers[0].PersistentKeepaliveInterval,
+			ReplaceAllowedIps:           true,
+			AllowedIps:                  dev.Peers[0].AllowedIps,
+		}},
+	}
+	got, err := parseConfig(quick)
+	if err != nil {
+		t.Fatalf("parseConfig: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected config (-want +got):\n%s", diff)
+	}
+
+	// The highest mark and an IPv6 endpoint survive the round trip.
+	dev.FirewallMark = 0x7fffffff
+	dev.Peers[0].Endpoint = &pb.UDPAddr{Ip: net.ParseIP("2001:db8::1"), Port: 51820}
+	want.FirewallMark = proto.Int32(0x7fffffff)
+	want.Peers[0].Endpoint = dev.Peers[0].Endpoint
+	rendered := renderConfig(dev, pb.ConfigFormat_SETCONF)
+	if !strings.Contains(rendered, "FwMark = 0x7fffffff\n") || !strings.Contains(rendered, "Endpoint = [2001:db8::1]:51820\n") {
+		t.Fatalf("unexpected setconf file:\n%s", rendered)
+	}
+	if got, err = parseConfig(rendered); err != nil {
+		t.Fatalf("parseConfig: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected config (-want +got):\n%s", diff)
+	}
+
+	// Secrets are left out of redacted devices.
+	RedactSecrets(dev)
+	for _, key := range []string{testPrivateKey, testPSK} {
+		if redacted := renderConfig(dev, pb.ConfigFormat_SETCONF); strings.Contains(redacted, key) {
+			t.Fatalf("redacted file contains %s:\n%s", key, redacted)
+		}
+	}
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	config := `# wg0
+ListenPort = 1
+[Interface]
+ListenPort = 70000
+PostUp = iptables -A FORWARD -i %i -j ACCEPT
+Foo = bar
+[Peer]
+PublicKey = AAAA
+AllowedIPs = 10.7.0.2/33
+PersistentKeepalive = off
+Endpoint = vpn.example.com:51820
+[Interface]
+FwMark = 0x80000000
+[Bar]
+`
+	_, err := parseConfig(config)
+	want := &ValidationError{Violations: []FieldViolation{
+		{Field: "config", Description: "line 2: ListenPort outside of a section"},
+		{Field: "config", Description: `line 4: ListenPort: invalid port "70000"`},
+		{Field: "config", Description: "line 6: unknown [Interface] key Foo"},
+		{Field: "config", Description: "line 8: PublicKey: wgtypes: incorrect key size: 3"},
+		{Field: "config", Description: `line 9: AllowedIPs: invalid network "10.7.0.2/33"`},
+		{Field: "config", Description: `line 11: Endpoint: "vpn.example.com:51820" is not an IP address and port, host names are not resolved`},
+		{Field: "config", Description: `line 13: FwMark: invalid mark "0x80000000", want at most 0x7fffffff`},
+		{Field: "config", Description: "line 14: unknown section [Bar]"},
+	}}
+	if diff := cmp.Diff(error(want), err, cmpErrors); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+	if !errors.Is(err, os.ErrInvalid) {
+		t.Fatalf("want os.ErrInvalid, got %v", err)
+	}
+}
+
+func TestImportConfig(t *testing.T) {
+	old, _ := wgtypes.GenerateKey()
+	wgs := WGServer{c: newTestKernel(&wgtypes.Device{
+		Name:  "wg0",
+		Peers: []wgtypes.Peer{{PublicKey: old}},
+	})}
+	dev, err := wgs.ImportConfig("wg0", "[Interface]\nListenPort = 51820\n\n[Peer]\nPublicKey = "+testPeerKey+"\nAllowedIPs = 10.7.0.2/32\n", "")
+	if err != nil {
+		t.Fatalf("ImportConfig: %v", err)
+	}
+	if diff := cmp.Diff(int32(51820), dev.ListenPort); diff != "" {
+		t.Fatalf("unexpected listen port (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(dev.Peers)); diff != "" {
+		t.Fatalf("peers are not replaced (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(mustKey(t, testPeerKey), dev.Peers[0].PublicKey); diff != "" {
+		t.Fatalf("unexpected peer (-want +got):\n%s", diff)
+	}
+}
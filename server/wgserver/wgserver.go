@@ -4,7 +4,7 @@ import (
 	"io"
 	"log"
 	"net"
-	"os"
+	"time"
 
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
 
@@ -26,21 +26,52 @@ type WGClient interface {
 // WGServer keeps data about wireguard server
 type WGServer struct {
 	c WGClient
-	d *wgtypes.Device
+	l LinkManager
+	s Store
+
+	locks    deviceLocks
+	ipam     ipam
+	watchers watchers
+}
+
+// Option configures a WGServer.
+type Option func(*WGServer)
+
+// WithStore records the desired state of every device changed through
+// WGServer in s, so Reconcile can restore it.
+func WithStore(s Store) Option {
+	return func(wgs *WGServer) {
+		wgs.s = s
+	}
 }
 
 // NewWGServer creates a new instance of WGServer
-func NewWGServer() (*WGServer, error) {
+func NewWGServer(opts ...Option) (*WGServer, error) {
 	c, err := wgctrl.New()
 	if err != nil {
 		return nil, err
 	}
-	return &WGServer{c: c}, nil
+	l, err := newLinkManager()
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	wgs := &WGServer{c: c, l: l}
+	for _, opt := range opts {
+		opt(wgs)
+	}
+	return wgs, nil
 }
 
 // Close closes the wireguard server
 func (wgs *WGServer) Close() error {
-	return wgs.c.Close()
+	err := wgs.c.Close()
+	if wgs.l != nil {
+		if lerr := wgs.l.Close(); err == nil {
+			err = lerr
+		}
+	}
+	return err
 }
 
 func pb2UDPAddr(pbUDP *pb.UDPAddr) *net.UDPAddr {
@@ -54,6 +85,36 @@ func pb2UDPAddr(pbUDP *pb.UDPAddr) *net.UDPAddr {
 	}
 }
 
+// pbInt2Int converts an optional proto integer into the optional int
+// wgctrl expects. An unset field stays nil so the device value is untouched.
+func pbInt2Int(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+// pbDuration2Duration converts an optional proto duration. An unset field
+// stays nil so the peer value is untouched.
+func pbDuration2Duration(d *durationpb.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	v := d.AsDuration()
+	return &v
+}
+
+func pb2IPNet(ipn *pb.IPNet) *net.IPNet {
+	if ipn == nil {
+		return nil
+	}
+	return &net.IPNet{
+		IP:   ipn.GetIp(),
+		Mask: ipn.GetIpMask(),
+	}
+}
+
 func pbKey2wgKey(key []byte) *wgtypes.Key {
 	if key == nil {
 		return nil
@@ -69,46 +130,126 @@ func pbKey2wgKey(key []byte) *wgtypes.Key {
 //
 // If the device specified by name does not exist or is not a WireGuard device,
 // an error is returned which can be checked using `errors.Is(err, os.ErrNotExist)`
-// os.ErrInvalid is returned on invalid input.
-func (wgs *WGServer) ConfigureDevice(name string, cfg *pb.Config) error {
+// A ValidationError matching os.ErrInvalid and listing every problem
+// is returned on invalid input.
+//
+// If revision is not empty, the device is only configured if it still has
+// that revision, an Aborted status error is returned otherwise. Changes of a
+// device are serialized, no other change comes between the check and the
+// configuration.
+func (wgs *WGServer) ConfigureDevice(name string, cfg *pb.Config, revision string) error {
+	verr := &ValidationError{}
 	if name == "" {
-		return os.ErrInvalid
+		verr.add("name", "must not be empty")
 	}
+	validateConfig(verr, "config", cfg)
+	if err := verr.err(); err != nil {
+		return err
+	}
+	defer wgs.lockDevices(name)()
+	if err := wgs.checkDeviceRevision(name, revision); err != nil {
+		return err
+	}
+	if err := wgs.c.ConfigureDevice(name, pbConfig2wgConfig(cfg)); err != nil {
+		return err
+	}
+	if err := wgs.record(name); err != nil {
+		return err
+	}
+	return wgs.releaseRemovedPeers(name)
+}
 
-	listenPort := int(cfg.GetListenPort())
-	fwMark := int(cfg.GetFirewallMark())
-
+// pbConfig2wgConfig converts a validated pb.Config into wgtypes.Config.
+func pbConfig2wgConfig(cfg *pb.Config) wgtypes.Config {
 	peers := make([]wgtypes.PeerConfig, 0, len(cfg.GetPeers()))
 	for _, p := range cfg.GetPeers() {
-		keepaliveInterval := p.GetPersistentKeepaliveInterval().AsDuration()
-
-		allowedIPs := make([]net.IPNet, 0, len(p.AllowedIps))
-		for _, ip := range p.AllowedIps {
-			allowedIPs = append(allowedIPs, net.IPNet{
-				IP:   ip.GetIp(),
-				Mask: ip.GetIpMask(),
-			})
-		}
-		peers = append(peers, wgtypes.PeerConfig{
-			PublicKey:                   *pbKey2wgKey(p.PublicKey),
-			Remove:                      p.GetRemove(),
-			UpdateOnly:                  p.GetUpdateOnly(),
-			PresharedKey:                pbKey2wgKey(p.PresharedKey),
-			Endpoint:                    pb2UDPAddr(p.Endpoint),
-			PersistentKeepaliveInterval: &keepaliveInterval,
-			ReplaceAllowedIPs:           p.GetReplaceAllowedIps(),
-			AllowedIPs:                  allowedIPs,
-		})
+		peers = append(peers, pbPeerConfig2wgPeerConfig(p))
 	}
-	wgCfg := wgtypes.Config{
+	return wgtypes.Config{
 		PrivateKey:   pbKey2wgKey(cfg.PrivateKey),
-		ListenPort:   &listenPort,
-		FirewallMark: &fwMark,
+		ListenPort:   pbInt2Int(cfg.ListenPort),
+		FirewallMark: pbInt2Int(cfg.FirewallMark),
 		ReplacePeers: cfg.GetReplacePeers(),
 		Peers:        peers,
 	}
+}
 
-	return wgs.c.ConfigureDevice(name, wgCfg)
+// pbPeerConfig2wgPeerConfig converts a validated pb.PeerConfig into wgtypes.PeerConfig.
+func pbPeerConfig2wgPeerConfig(p *pb.PeerConfig) wgtypes.PeerConfig {
+	allowedIPs := make([]net.IPNet, 0, len(p.AllowedIps))
+	for _, ip := range p.AllowedIps {
+		allowedIPs = append(allowedIPs, net.IPNet{
+			IP:   ip.GetIp(),
+			Mask: ip.GetIpMask(),
+		})
+	}
+	return wgtypes.PeerConfig{
+		PublicKey:                   *pbKey2wgKey(p.PublicKey), // validated by validatePeerConfig
+		Remove:                      p.GetRemove(),
+		UpdateOnly:                  p.GetUpdateOnly(),
+		PresharedKey:                pbKey2wgKey(p.PresharedKey),
+		Endpoint:                    pb2UDPAddr(p.Endpoint),
+		PersistentKeepaliveInterval: pbDuration2Duration(p.PersistentKeepaliveInterval),
+		ReplaceAllowedIPs:           p.GetReplaceAllowedIps(),
+		AllowedIPs:                  allowedIPs,
+	}
+}
+
+// CreateDevice creates a WireGuard link and applies cfg to it, if not nil.
+//
+// An error matching os.ErrExist is returned if a link with the name already exists.
+// If cfg can not be applied or the device can not be recorded in the store,
+// the link is removed again.
+func (wgs *WGServer) CreateDevice(name string, cfg *pb.Config) (*pb.Device, error) {
+	verr := &ValidationError{}
+	validateLinkName(verr, "name", name)
+	validateConfig(verr, "config", cfg)
+	if err := verr.err(); err != nil {
+		return nil, err
+	}
+	defer wgs.lockDevices(name)()
+	if err := wgs.l.CreateLink(name); err != nil {
+		return nil, err
+	}
+	deleteLink := func() {
+		if derr := wgs.l.DeleteLink(name); derr != nil {
+			log.Printf("Deleting link %s: %s", name, derr)
+		}
+	}
+	if cfg != nil {
+		if err := wgs.c.ConfigureDevice(name, pbConfig2wgConfig(cfg)); err != nil {
+			deleteLink()
+			return nil, err
+		}
+	}
+	if err := wgs.record(name); err != nil {
+		deleteLink()
+		return nil, err
+	}
+	return wgs.Device(name)
+}
+
+// DeleteDevice deletes a WireGuard link by its interface name.
+//
+// If the device specified by name does not exist or is not a WireGuard device,
+// an error is returned which can be checked using `errors.Is(err, os.ErrNotExist)`.
+// The address pools and leases of the device are removed with it.
+func (wgs *WGServer) DeleteDevice(name string) error {
+	if name == "" {
+		return invalidField("name", "must not be empty")
+	}
+	defer wgs.lockDevices(name)()
+	// Only WireGuard links are removed, wgctrl reports others as missing.
+	if _, err := wgs.c.Device(name); err != nil {
+		return err
+	}
+	if err := wgs.l.DeleteLink(name); err != nil {
+		return err
+	}
+	if err := wgs.forget(name); err != nil {
+		return err
+	}
+	return wgs.dropPools(name)
 }
 
 // Devices retrieves all WireGuard devices on this system.
@@ -124,6 +265,7 @@ func (wgs *WGServer) Devices() ([]*pb.Device, error) {
 			log.Printf("Converting to PB: %s", err)
 			continue
 		}
+		wgs.setLinkInfo(pbDev)
 		pbDevices = append(pbDevices, pbDev)
 	}
 	return pbDevices, nil
@@ -135,13 +277,18 @@ func (wgs *WGServer) Devices() ([]*pb.Device, error) {
 // an error is returned which can be checked using `errors.Is(err, os.ErrNotExist)`.
 func (wgs *WGServer) Device(name string) (*pb.Device, error) {
 	if name == "" {
-		return nil, os.ErrInvalid
+		return nil, invalidField("name", "must not be empty")
 	}
 	dev, err := wgs.c.Device(name)
 	if err != nil {
 		return nil, err
 	}
-	return convertWGDeviceToPb(dev)
+	pbDev, err := convertWGDeviceToPb(dev)
+	if err != nil {
+		return nil, err
+	}
+	wgs.setLinkInfo(pbDev)
+	return pbDev, nil
 }
 
 func udpAddr2Pb(udpAddr *net.UDPAddr) *pb.UDPAddr {
@@ -155,36 +302,19 @@ func udpAddr2Pb(udpAddr *net.UDPAddr) *pb.UDPAddr {
 	}
 }
 
+// wgKey2pbKey copies the key, so the result does not alias a loop variable.
 func wgKey2pbKey(key *wgtypes.Key) []byte {
 	if key == nil {
 		return nil
 	}
-	return key[:]
+	return append([]byte(nil), key[:]...)
 }
 
 // convertWGDeviceToPb converts wgtypes.Device into pb.Device
 func convertWGDeviceToPb(dev *wgtypes.Device) (*pb.Device, error) {
 	peers := make([]*pb.Peer, 0, len(dev.Peers))
-	for _, p := range dev.Peers {
-		allowedIPs := make([]*pb.IPNet, 0, len(p.AllowedIPs))
-		for _, ip := range p.AllowedIPs {
-			allowedIPs = append(allowedIPs, &pb.IPNet{
-				Ip:     ip.IP,
-				IpMask: ip.Mask,
-			})
-		}
-		protoVersion := int32(p.ProtocolVersion)
-		peers = append(peers, &pb.Peer{
-			PublicKey:                   wgKey2pbKey(&p.PublicKey),
-			PresharedKey:                wgKey2pbKey(&p.PresharedKey),
-			Endpoint:                    udpAddr2Pb(p.Endpoint),
-			PersistentKeepaliveInterval: durationpb.New(p.PersistentKeepaliveInterval),
-			LastHandshakeTime:           timestamppb.New(p.LastHandshakeTime),
-			RecievedBytes:               p.ReceiveBytes,
-			TransmitBytes:               p.TransmitBytes,
-			AllowedIps:                  allowedIPs,
-			ProtocolVersion:             protoVersion,
-		})
+	for i := range dev.Peers {
+		peers = append(peers, convertWGPeerToPb(&dev.Peers[i]))
 	}
 	return &pb.Device{
 		Name:         dev.Name,
@@ -194,5 +324,48 @@ func convertWGDeviceToPb(dev *wgtypes.Device) (*pb.Device, error) {
 		ListenPort:   int32(dev.ListenPort),
 		FirewallMark: int32(dev.FirewallMark),
 		Peers:        peers,
+		Revision:     revision(dev),
 	}, nil
 }
+
+// RedactSecrets clears the private key of the device and the preshared keys
+// of its peers in place.
+func RedactSecrets(dev *pb.Device) {
+	if dev == nil {
+		return
+	}
+	dev.PrivateKey = nil
+	RedactPeerSecrets(dev.Peers...)
+}
+
+// RedactPeerSecrets clears the preshared keys of the peers in place.
+func RedactPeerSecrets(peers ...*pb.Peer) {
+	for _, p := range peers {
+		if p != nil {
+			p.PresharedKey = nil
+		}
+	}
+}
+
+// convertWGPeerToPb converts wgtypes.Peer into pb.Peer
+func convertWGPeerToPb(p *wgtypes.Peer) *pb.Peer {
+	allowedIPs := make([]*pb.IPNet, 0, len(p.AllowedIPs))
+	for _, ip := range p.AllowedIPs {
+		allowedIPs = append(allowedIPs, &pb.IPNet{
+			Ip:     ip.IP,
+			IpMask: ip.Mask,
+		})
+	}
+	return &pb.Peer{
+		PublicKey:                   wgKey2pbKey(&p.PublicKey),
+		PresharedKey:                wgKey2pbKey(&p.PresharedKey),
+		Endpoint:                    udpAddr2Pb(p.Endpoint),
+		PersistentKeepaliveInterval: durationpb.New(p.PersistentKeepaliveInterval),
+		LastHandshakeTime:           timestamppb.New(p.LastHandshakeTime),
+		RecievedBytes:               p.ReceiveBytes,
+		TransmitBytes:               p.TransmitBytes,
+		AllowedIps:                  allowedIPs,
+		ProtocolVersion:             int32(p.ProtocolVersion),
+		HasPresharedKey:             p.PresharedKey != wgtypes.Key{},
+	}
+}
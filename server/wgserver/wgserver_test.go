@@ -4,11 +4,14 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	pb "github.com/atsevan/wireguard-grpc/pb/wg"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var (
@@ -20,11 +23,11 @@ var (
 func TestWGServerClose(t *testing.T) {
 	var calls int
 	fakeClose := func() error { calls++; return nil }
-	wgs := WGServer{c: &testClient{CloseFunc: fakeClose}}
+	wgs := WGServer{c: &testClient{CloseFunc: fakeClose}, l: &testLinkManager{CloseFunc: fakeClose}}
 	if err := wgs.Close(); err != nil {
 		t.Fatalf("failed to close: %v", err)
 	}
-	if diff := cmp.Diff(1, calls); diff != "" {
+	if diff := cmp.Diff(2, calls); diff != "" {
 		t.Fatalf("unexpected number of clients closed (-want +got):\n%s", diff)
 	}
 }
@@ -46,7 +49,7 @@ func TestConfigureDevice(t *testing.T) {
 
 		cfg = &pb.Config{
 			PrivateKey:   privateKey[:],
-			ListenPort:   8080,
+			ListenPort:   proto.Int32(8080),
 			ReplacePeers: true,
 			Peers: []*pb.PeerConfig{
 				{
@@ -85,19 +88,25 @@ func TestConfigureDevice(t *testing.T) {
 			devName: "wg0",
 			wgFn:    ok,
 			err:     nil,
+		}, {
+			name:    "malformed peer key",
+			cfg:     &pb.Config{Peers: []*pb.PeerConfig{{PublicKey: []byte{1, 2, 3}}}},
+			devName: "wg0",
+			wgFn:    ok,
+			err:     invalidField("config.peers[0].public_key", "must be 32 bytes, got 3"),
 		}, {
 			name:    "empty devName",
 			cfg:     cfg,
 			devName: "",
 			wgFn:    ok,
-			err:     os.ErrInvalid,
+			err:     invalidField("name", "must not be empty"),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			wgs := WGServer{c: &testClient{ConfigureDeviceFunc: tt.wgFn}}
-			err := wgs.ConfigureDevice(tt.devName, tt.cfg)
+			err := wgs.ConfigureDevice(tt.devName, tt.cfg, "")
 			if diff := cmp.Diff(tt.err, err, cmpErrors); diff != "" {
 				t.Fatalf("unexpected error (-want +got):\n%s", diff)
 			}
@@ -105,6 +114,87 @@ func TestConfigureDevice(t *testing.T) {
 	}
 }
 
+func TestConfigureDeviceFieldPresence(t *testing.T) {
+	existingKey, _ := wgtypes.GenerateKey()
+	newKey, _ := wgtypes.GenerateKey()
+	keepalive := 25 * time.Second
+
+	tests := []struct {
+		name          string
+		cfg           *pb.Config
+		wantPort      int
+		wantMark      int
+		wantKeepalive time.Duration
+	}{
+		{
+			name: "add peer keeps untouched fields",
+			cfg: &pb.Config{
+				Peers: []*pb.PeerConfig{{PublicKey: newKey[:]}},
+			},
+			wantPort:      51820,
+			wantMark:      7,
+			wantKeepalive: keepalive,
+		},
+		{
+			name: "explicit zero values are passed through",
+			cfg: &pb.Config{
+				ListenPort:   proto.Int32(0),
+				FirewallMark: proto.Int32(0),
+				Peers: []*pb.PeerConfig{{
+					PublicKey:                   existingKey[:],
+					PersistentKeepaliveInterval: durationpb.New(0),
+				}},
+			},
+			wantPort:      0,
+			wantMark:      0,
+			wantKeepalive: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev := &wgtypes.Device{
+				ListenPort:   51820,
+				FirewallMark: 7,
+				Peers: []wgtypes.Peer{{
+					PublicKey:                   existingKey,
+					PersistentKeepaliveInterval: keepalive,
+				}},
+			}
+			// apply mimics the kernel: only non-nil fields are changed.
+			apply := func(_ string, cfg wgtypes.Config) error {
+				if cfg.ListenPort != nil {
+					dev.ListenPort = *cfg.ListenPort
+				}
+				if cfg.FirewallMark != nil {
+					dev.FirewallMark = *cfg.FirewallMark
+				}
+				for _, pc := range cfg.Peers {
+					for i := range dev.Peers {
+						if dev.Peers[i].PublicKey == pc.PublicKey && pc.PersistentKeepaliveInterval != nil {
+							dev.Peers[i].PersistentKeepaliveInterval = *pc.PersistentKeepaliveInterval
+						}
+					}
+				}
+				return nil
+			}
+			wgs := WGServer{c: &testClient{ConfigureDeviceFunc: apply}}
+			if err := wgs.ConfigureDevice("wg0", tt.cfg, ""); err != nil {
+				t.Fatalf("failed to configure device: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPort, dev.ListenPort); diff != "" {
+				t.Errorf("unexpected listen port (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantMark, dev.FirewallMark); diff != "" {
+				t.Errorf("unexpected firewall mark (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantKeepalive, dev.Peers[0].PersistentKeepaliveInterval); diff != "" {
+				t.Errorf("unexpected keepalive interval (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDevices(t *testing.T) {
 	var (
 		clientOkFn = func() ([]*wgtypes.Device, error) {
@@ -198,7 +288,7 @@ func TestDevice(t *testing.T) {
 			name:     "empty name",
 			in:       "",
 			clientFn: clientOkFn,
-			err:      os.ErrInvalid,
+			err:      invalidField("name", "must not be empty"),
 			resp:     &pb.Device{},
 		},
 	}
@@ -302,6 +392,43 @@ func TestConvertWGDeviceToPb(t *testing.T) {
 	}
 }
 
+func TestRedactSecrets(t *testing.T) {
+	privateKey, _ := wgtypes.GeneratePrivateKey()
+	psk, _ := wgtypes.GenerateKey()
+	dev, err := convertWGDeviceToPb(&wgtypes.Device{
+		Name:       "wg0",
+		PrivateKey: privateKey,
+		PublicKey:  privateKey.PublicKey(),
+		Peers: []wgtypes.Peer{
+			{PublicKey: privateKey.PublicKey(), PresharedKey: psk},
+			{PublicKey: psk},
+		},
+	})
+	if err != nil {
+		t.Fatalf("convertWGDeviceToPb: %v", err)
+	}
+	if diff := cmp.Diff([]byte(psk[:]), dev.Peers[0].PresharedKey); diff != "" {
+		t.Fatalf("unexpected preshared key before redaction (-want +got):\n%s", diff)
+	}
+
+	RedactSecrets(dev)
+
+	if dev.PrivateKey != nil {
+		t.Errorf("private key is not redacted")
+	}
+	if diff := cmp.Diff([]byte(privateKey.PublicKey().String()), []byte(wgtypes.Key(dev.PublicKey).String())); diff != "" {
+		t.Errorf("unexpected public key (-want +got):\n%s", diff)
+	}
+	for i, want := range []bool{true, false} {
+		if dev.Peers[i].PresharedKey != nil {
+			t.Errorf("preshared key of peer %d is not redacted", i)
+		}
+		if diff := cmp.Diff(want, dev.Peers[i].HasPresharedKey); diff != "" {
+			t.Errorf("unexpected has_preshared_key of peer %d (-want +got):\n%s", i, diff)
+		}
+	}
+}
+
 func TestPb2UDPAddr(t *testing.T) {
 	tests := []struct {
 		name string